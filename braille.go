@@ -0,0 +1,122 @@
+// Bharati Braille (Thamizh) conversion
+
+package script
+
+import (
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// Gets the Unicode Braille pattern (U+2800 block) of the given dot numbers (e.g. "134")
+func brailleCell(dots string) rune {
+	r := rune(0x2800)
+	for _, d := range dots {
+		r |= 1 << (d - '1')
+	}
+	return r
+}
+
+// Braille cells of the 12 vowels (அ - ஔ)
+var brailleVowels = [base.VowelCount]rune{
+	brailleCell("1"), brailleCell("345"), brailleCell("24"), brailleCell("35"), brailleCell("136"), brailleCell("1256"),
+	brailleCell("26"), brailleCell("15"), brailleCell("34"), brailleCell("1346"), brailleCell("135"), brailleCell("246"),
+}
+
+// Braille cells of the consonant rows (க - ன, followed by ஜ ஶ ஷ ஸ ஹ க்ஷ)
+var brailleConsonants = [base.ConsonantCount]rune{
+	brailleCell("13"), brailleCell("346"), brailleCell("14"), brailleCell("25"), brailleCell("23456"), brailleCell("3456"),
+	brailleCell("2345"), brailleCell("1345"), brailleCell("1234"), brailleCell("134"), brailleCell("13456"), brailleCell("1235"),
+	brailleCell("123"), brailleCell("1236"), brailleCell("12356"), brailleCell("456"), brailleCell("12456"), brailleCell("56"),
+	brailleCell("245"), brailleCell("146"), brailleCell("12346"), brailleCell("234"), brailleCell("125"), brailleCell("12345"),
+}
+
+var (
+	braillePulli  = brailleCell("4") // Pulli (மெய்) marker; follows the consonant cell
+	brailleAytham = brailleCell("6")
+)
+
+// Braille cell => Letter index (vowel, or consonant) lookup
+var brailleLetters = func() map[rune]uint16 {
+	letters := make(map[rune]uint16)
+	for v, r := range brailleVowels {
+		letters[r] = uint16(v)
+	}
+	for row, r := range brailleConsonants {
+		letters[r] = base.CIdx(uint8(row))
+	}
+	letters[brailleAytham] = base.AythamIdx
+	return letters
+}()
+
+// Converts the string into Bharati Braille (Unicode Braille patterns)
+//
+// CV letters are written as consonant cell followed by vowel cell, the inherent அ being implicit (க => ⠅).
+// C letters are written as consonant cell followed by the pulli cell (க் => ⠅⠈).
+// The implicit அ is written explicitly when followed by a vowel letter (கஇ => ⠅⠁⠊), keeping the conversion lossless.
+func (s String) Braille() string {
+	var cells []rune
+	for i, idx := range s.idxs {
+		chr := Letter{idx: idx}
+		switch {
+		case chr.IsV():
+			cells = append(cells, brailleVowels[idx])
+		case chr.IsC():
+			cells = append(cells, brailleConsonants[base.CRow(idx)], braillePulli)
+		case chr.IsCV():
+			c, v := chr.SplitCV()
+			cells = append(cells, brailleConsonants[base.CRow(c.idx)])
+			if v.idx != 0 || i+1 < len(s.idxs) && s.LetterAt(i+1).IsV() {
+				cells = append(cells, brailleVowels[v.idx])
+			}
+		case chr.IsAytham():
+			cells = append(cells, brailleAytham)
+		default: // ஸ்ரீ
+			lead, rest, _ := base.SplitConjunct(idx)
+			cells = append(cells, []rune(String{idxs: []uint16{lead, rest}}.Braille())...)
+		}
+	}
+	return string(cells)
+}
+
+// Parses the given Bharati Braille (Unicode Braille patterns) into Thamizh string
+//
+// Consonant cell takes the immediately following vowel cell as its vowel (inherent அ otherwise).
+// On failure, returns *DecodeError locating the offending cell.
+func ParseBraille(braille string) (String, error) {
+	var idxs []uint16
+	pending := -1 // Consonant row awaiting its vowel/pulli
+	flush := func() {
+		if pending >= 0 {
+			idxs = appendJoined(idxs, base.CVIdx(uint8(pending), 0))
+			pending = -1
+		}
+	}
+	runeOffset := -1
+	for byteOffset, r := range braille {
+		runeOffset++
+		if r == braillePulli && pending >= 0 {
+			idxs = appendJoined(idxs, base.CIdx(uint8(pending)))
+			pending = -1
+			continue
+		}
+		idx, ok := brailleLetters[r]
+		if !ok {
+			return String{}, &DecodeError{ByteOffset: byteOffset, RuneOffset: runeOffset, Rune: r, Reason: ErrUnsupportedCode}
+		}
+		switch {
+		case base.IsV(idx) && pending >= 0:
+			idxs = appendJoined(idxs, base.CVIdx(uint8(pending), uint8(idx)))
+			pending = -1
+		case base.IsC(idx):
+			flush()
+			pending = int(base.CRow(idx))
+		default:
+			flush()
+			idxs = append(idxs, idx)
+		}
+	}
+	flush()
+	if idxs == nil {
+		return String{}, &DecodeError{Reason: ErrEmptyString}
+	}
+	return String{idxs: idxs}, nil
+}
@@ -0,0 +1,47 @@
+package script_test // Black box test
+
+import (
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestBraille(t *testing.T) {
+	tests := []struct {
+		ustr    string
+		braille string
+	}{
+		{"அ", "⠁"},
+		{"க", "⠅"},
+		{"க்", "⠅⠈"},
+		{"கா", "⠅⠜"},
+		{"தமிழ்", "⠞⠍⠊⠷⠈"},
+		{"கஇ", "⠅⠁⠊"},
+		{"எஃகு", "⠢⠠⠅⠥"},
+		{"ஸ்ரீ", "⠎⠈⠗⠔"},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		if got := s.Braille(); got != tc.braille {
+			t.Errorf("Braille %s, expected %s, got %s", tc.ustr, tc.braille, got)
+		}
+		if got, err := script.ParseBraille(tc.braille); err != nil || got.String() != tc.ustr {
+			t.Errorf("Parse Braille %s, expected %s, got %s (%v)", tc.braille, tc.ustr, got, err)
+		}
+	}
+
+	letters := append(nativeLetterUStrs(), "ஜ", "ஶ்", "ஷா", "ஸி", "ஹௌ", "க்ஷை", "ஸ்ரீ")
+	for _, a := range letters {
+		for _, b := range append([]string{""}, letters...) {
+			s := script.MustDecode(a + b)
+			got, err := script.ParseBraille(s.Braille())
+			if err != nil || got.String() != s.String() {
+				t.Fatalf("Braille round trip %s (%s), got %s (%v)", s, s.Braille(), got, err)
+			}
+		}
+	}
+
+	if _, err := script.ParseBraille("⠈⠅"); err == nil {
+		t.Errorf("Parse Braille error expected for leading pulli")
+	}
+}
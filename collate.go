@@ -0,0 +1,140 @@
+// Thamizh dictionary collation (அகர வரிசை)
+
+package script
+
+import (
+	"encoding/binary"
+	"slices"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+/*
+	Traditional dictionary order:
+	1. Vowels அ - ஔ, then ஃ
+	2. Consonant rows க - ன; within a row, the consonant precedes its CV letters (க், க, கா, ... கௌ)
+	3. Grantha rows as per the Grantha placement; ஸ்ரீ collates as ஸ் + ரீ
+*/
+
+// Placement of the Grantha letters in the collation order
+type GranthaPlacement uint8
+
+const (
+	// Grantha rows (ஜ ஶ ஷ ஸ ஹ க்ஷ) after the native rows (after ன)
+	GranthaLast GranthaPlacement = iota
+
+	// Grantha rows after their nearest native row: க்ஷ ஹ after க; ஜ ஶ ஷ ஸ after ச
+	GranthaInterleaved
+)
+
+// Thamizh dictionary collator; the zero value collates in the traditional order, Grantha last
+type Collator struct {
+	Grantha GranthaPlacement
+
+	// Ignore the vowel length at the primary level (கடு, காடு, கடை; instead of கடு, கடை, காடு);
+	// the vowel length still breaks the ties (secondary level)
+	IgnoreVowelLength bool
+}
+
+// Consonant rows in the collation order, as per the Grantha placement
+var collationRows = [...][base.ConsonantCount]uint8{
+	GranthaLast: {
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17,
+		18, 19, 20, 21, 22, 23,
+	},
+	GranthaInterleaved: {
+		0, 23, 22, 1, 2, 18, 19, 20, 21, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16, 17,
+	},
+}
+
+// Collation rank of each consonant row, as per the Grantha placement
+var collationRowRanks = func() [len(collationRows)][base.ConsonantCount]uint16 {
+	var ranks [len(collationRows)][base.ConsonantCount]uint16
+	for p, rows := range collationRows {
+		for rank, row := range rows {
+			ranks[p][row] = uint16(rank)
+		}
+	}
+	return ranks
+}()
+
+// Short vowel column of each vowel column (ஆ => அ ...); ஐ and ஔ stand by themselves
+var shortVowelCols = [base.VowelCount]uint8{0, 0, 2, 2, 4, 4, 6, 6, 8, 9, 9, 11}
+
+// Collation weights (non-zero): vowels, ஃ, then each consonant row (consonant, followed by its CV letters)
+const (
+	vowelWeightBase  = 1
+	aythamWeight     = vowelWeightBase + base.VowelCount
+	rowWeightBase    = aythamWeight + 1
+	rowWeightsCount  = 1 + base.VowelCount
+	weightsSeparator = 0 // Separates the collation levels in the sort key
+)
+
+// Collation weights of the string letters; fold collapses the vowel length
+func (c Collator) weights(s String, fold bool) []uint16 {
+	ranks := &collationRowRanks[c.Grantha]
+	col := func(col uint8) uint16 {
+		if fold {
+			col = shortVowelCols[col]
+		}
+		return uint16(col)
+	}
+	weights := make([]uint16, 0, len(s.idxs)+1)
+	var weigh func(idx uint16)
+	weigh = func(idx uint16) {
+		switch {
+		case base.IsV(idx):
+			weights = append(weights, vowelWeightBase+col(uint8(idx)))
+		case base.IsC(idx):
+			weights = append(weights, rowWeightBase+ranks[base.CRow(idx)]*rowWeightsCount)
+		case base.IsCV(idx):
+			row, vcol := base.CVRowCol(idx)
+			weights = append(weights, rowWeightBase+ranks[row]*rowWeightsCount+1+col(vcol))
+		case base.IsAytham(idx):
+			weights = append(weights, aythamWeight)
+		default: // ஸ்ரீ
+			lead, rest, _ := base.SplitConjunct(idx)
+			weigh(lead)
+			weigh(rest)
+		}
+	}
+	for _, idx := range s.idxs {
+		weigh(idx)
+	}
+	return weights
+}
+
+// Compares the two strings in the collation order; -1, 0 or +1
+func (c Collator) Compare(a, b String) int {
+	cmp := slices.Compare(c.weights(a, c.IgnoreVowelLength), c.weights(b, c.IgnoreVowelLength))
+	if cmp != 0 || !c.IgnoreVowelLength {
+		return cmp
+	}
+	return slices.Compare(c.weights(a, false), c.weights(b, false))
+}
+
+// Sort key of the string; sort keys compare (bytes.Compare) as their strings do (Compare)
+//
+// Key format: big-endian uint16 weights; primary level weights, separator (0) and the secondary
+// level weights follow, when ignoring the vowel length.
+func (c Collator) SortKey(s String) []byte {
+	weights := c.weights(s, c.IgnoreVowelLength)
+	if c.IgnoreVowelLength {
+		weights = append(weights, weightsSeparator)
+		weights = append(weights, c.weights(s, false)...)
+	}
+	key := make([]byte, 2*len(weights))
+	for i, w := range weights {
+		binary.BigEndian.PutUint16(key[2*i:], w)
+	}
+	return key
+}
+
+// Compares the two strings in the traditional dictionary order (Grantha last); -1, 0 or +1
+//
+// Usable with slices.SortFunc.
+func Compare(a, b String) int { return Collator{}.Compare(a, b) }
+
+// Sort key of the string, in the traditional dictionary order (Grantha last)
+func SortKey(s String) []byte { return Collator{}.SortKey(s) }
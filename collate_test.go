@@ -0,0 +1,66 @@
+package script_test // Black box test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		collator script.Collator
+		sorted   []string
+	}{
+		{script.Collator{}, []string{
+			"அம்மா", "ஆடு", "எஃகு", "ஔவை", "கக்கம்", "கடல்", "கடை", "காடு", "கொடி", "கோடி", "கௌரவம்",
+			"ஙனம்", "சக்கரம்", "ஞானம்", "னகரம்", "ஜனவரி", "ஸ்ரீ", "ஸ்ரீதர்", "ஹரி", "க்ஷேமம்",
+		}},
+		{script.Collator{Grantha: script.GranthaInterleaved}, []string{
+			"கடல்", "க்ஷேமம்", "ஹரி", "ஙனம்", "சக்கரம்", "ஜனவரி", "ஷண்முகம்", "ஸ்ரீ", "ஞானம்", "னகரம்",
+		}},
+		{script.Collator{IgnoreVowelLength: true}, []string{
+			"கடு", "காடு", "கடை", "கொடி", "கோடி", "கொடு",
+		}},
+	}
+	for _, tc := range tests {
+		strs := make([]script.String, len(tc.sorted))
+		for i, ustr := range tc.sorted {
+			strs[i] = script.MustDecode(ustr)
+		}
+		shuffled := slices.Clone(strs)
+		slices.Reverse(shuffled)
+		slices.SortFunc(shuffled, tc.collator.Compare)
+		for i, s := range shuffled {
+			if s.String() != tc.sorted[i] {
+				t.Errorf("Sort %+v at %d, expected %s, got %s", tc.collator, i, tc.sorted[i], s)
+			}
+		}
+		for i := range strs {
+			for j := range strs {
+				cmp := tc.collator.Compare(strs[i], strs[j])
+				keyCmp := bytes.Compare(tc.collator.SortKey(strs[i]), tc.collator.SortKey(strs[j]))
+				if cmp != keyCmp || cmp != compareInts(i, j) {
+					t.Errorf("Compare %s %s, expected %d, got %d (sort key %d)", strs[i], strs[j], compareInts(i, j), cmp, keyCmp)
+				}
+			}
+		}
+	}
+
+	a, b := script.MustDecode("கௌ"), script.MustDecode("கொ")
+	if script.Compare(a, b) <= 0 || bytes.Compare(script.SortKey(a), script.SortKey(b)) <= 0 {
+		t.Errorf("Compare கௌ கொ, expected கௌ after கொ")
+	}
+}
+
+func compareInts(i, j int) int {
+	switch {
+	case i < j:
+		return -1
+	case i > j:
+		return 1
+	default:
+		return 0
+	}
+}
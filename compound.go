@@ -0,0 +1,105 @@
+// Compound word splitter (reverse sandhi; பிரித்தல்)
+
+package script
+
+import (
+	"slices"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// Proposed split of a compound word into its constituent words
+type CompoundSplit struct {
+	Left, Right String
+	Rule        SandhiRule // Sandhi rule explaining the split; 0 for the plain join (Append)
+	Score       int        // Count of the constituent words found in the lexicon
+}
+
+// Proposes the splits of the compound word (மரக்கிளை => மரம் + கிளை), walking its split points
+//
+// Each split undoes a known sandhi transformation, and is verified to Join back (by its rule alone)
+// into the given word. The optional lexicon (nil for none) ranks the splits with known constituent
+// words first; then the splits explained by a rule, then by the split point.
+func SplitCompound(s String, lexicon func(word String) bool) []CompoundSplit {
+	var splits []CompoundSplit
+	propose := func(left, right []uint16, rule SandhiRule) {
+		if len(left) == 0 || len(right) == 0 || base.IsC(right[0]) || len(left) == 1 && base.IsC(left[0]) {
+			return // Words neither start with nor are just a consonant
+		}
+		split := CompoundSplit{Left: String{idxs: left}, Right: String{idxs: right}, Rule: rule}
+		joined, _ := Join(split.Left, split.Right, SandhiOptions{Disabled: AllSandhiRules &^ rule, Enabled: rule})
+		if !slices.Equal(joined.idxs, s.idxs) || slices.ContainsFunc(splits, split.equal) {
+			return
+		}
+		if lexicon != nil {
+			for _, word := range []String{split.Left, split.Right} {
+				if lexicon(word) {
+					split.Score++
+				}
+			}
+		}
+		splits = append(splits, split)
+	}
+	// Make new slices for the extended split parts, never appending into the original slice
+	with := func(idxs []uint16, tail ...uint16) []uint16 { return append(slices.Clip(idxs), tail...) }
+
+	for i, idx := range s.idxs {
+		before, after := s.idxs[:i], s.idxs[i+1:]
+		propose(before, s.idxs[i:], 0)
+
+		var prev Letter // Preceding letter, if any
+		if i > 0 {
+			prev = s.LetterAt(i - 1)
+		}
+		if base.IsCV(idx) {
+			row, col := base.CVRowCol(idx)
+			c, v := base.CIdx(row), uint16(col)
+			propose(with(before, c), with([]uint16{v}, after...), 0)                                       // தமிழினிது => தமிழ் + இனிது
+			propose(with(before, base.CVIdx(row, base.ColU)), with([]uint16{v}, after...), SandhiUElision) // நாடழகு => நாடு + அழகு
+			if i > 0 && prev.idx == c {
+				propose(before, with([]uint16{v}, after...), SandhiGemination) // பொன்னாடை => பொன் + ஆடை
+			}
+			if i > 0 && (row == base.RowYa || row == base.RowVa) {
+				propose(before, with([]uint16{v}, after...), SandhiGlide) // மணியடி => மணி + அடி
+			}
+		}
+		if i == 0 || !prev.IsC() {
+			continue
+		}
+		before = s.idxs[:i-1]
+		propose(before, s.idxs[i:], SandhiDoubling)                           // அந்தக்கடை => அந்த + கடை
+		propose(with(before, base.CIdx(base.RowMa)), s.idxs[i:], SandhiMDrop) // மரக்கிளை => மரம் + கிளை
+		propose(with(before, base.CIdx(base.RowMa)), s.idxs[i:], SandhiNasal) // மரங்கள் => மரம் + கள்
+		for _, lateral := range []uint16{base.CIdx(base.RowLa), base.CIdx(base.RowLla)} {
+			propose(with(before, lateral), s.idxs[i:], SandhiLateral) // பற்பொடி => பல் + பொடி
+			if base.IsCV(idx) {
+				_, col := base.CVRowCol(idx)
+				propose(with(before, lateral), with([]uint16{base.CVIdx(base.RowTa, col)}, after...), SandhiLateral) // கற்றூண் => கல் + தூண்
+			}
+		}
+	}
+
+	slices.SortStableFunc(splits, func(a, b CompoundSplit) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return compareBools(a.Rule != 0, b.Rule != 0)
+	})
+	return splits
+}
+
+func (a CompoundSplit) equal(b CompoundSplit) bool {
+	return a.Rule == b.Rule && slices.Equal(a.Left.idxs, b.Left.idxs) && slices.Equal(a.Right.idxs, b.Right.idxs)
+}
+
+// True first
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,73 @@
+package script_test // Black box test
+
+import (
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestSplitCompound(t *testing.T) {
+	lexicon := map[string]bool{}
+	for _, w := range []string{"மரம்", "கிளை", "பொன்", "ஆடை", "நாடு", "அழகு", "மணி", "அடி", "கல்", "தூண்", "தமிழ்", "இனிது", "அந்த", "கடை"} {
+		lexicon[w] = true
+	}
+	known := func(word script.String) bool { return lexicon[word.String()] }
+
+	tests := []struct {
+		ustr         string
+		uleft, urght string
+		rule         script.SandhiRule
+	}{
+		{"மரக்கிளை", "மரம்", "கிளை", script.SandhiMDrop},
+		{"பொன்னாடை", "பொன்", "ஆடை", script.SandhiGemination},
+		{"நாடழகு", "நாடு", "அழகு", script.SandhiUElision},
+		{"மணியடி", "மணி", "அடி", script.SandhiGlide},
+		{"கற்றூண்", "கல்", "தூண்", script.SandhiLateral},
+		{"தமிழினிது", "தமிழ்", "இனிது", 0},
+		{"அந்தக்கடை", "அந்த", "கடை", script.SandhiDoubling},
+		{"மரங்கிளை", "மரம்", "கிளை", script.SandhiNasal},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		splits := script.SplitCompound(s, known)
+		if len(splits) == 0 {
+			t.Errorf("Split %s, expected %s + %s, got none", tc.ustr, tc.uleft, tc.urght)
+			continue
+		}
+		top := splits[0]
+		if top.Left.String() != tc.uleft || top.Right.String() != tc.urght || top.Rule != tc.rule || top.Score != 2 {
+			t.Errorf("Split %s, expected %s + %s (%v), got %s + %s (%v, score %d)",
+				tc.ustr, tc.uleft, tc.urght, tc.rule, top.Left, top.Right, top.Rule, top.Score)
+		}
+		for _, split := range splits {
+			joined, _ := script.Join(split.Left, split.Right, script.SandhiOptions{Disabled: script.AllSandhiRules &^ split.Rule, Enabled: split.Rule})
+			if joined.String() != tc.ustr {
+				t.Errorf("Split %s, got %s + %s (%v) joining into %s", tc.ustr, split.Left, split.Right, split.Rule, joined)
+			}
+		}
+	}
+
+	// Round trip with the opt-in rules switched on; மரக்கிளை => மரம் + கிளை => மரக்கிளை
+	split := script.SplitCompound(script.MustDecode("மரக்கிளை"), known)[0]
+	if joined, _ := script.Join(split.Left, split.Right, script.SandhiOptions{Enabled: script.OptInSandhiRules}); joined.String() != "மரக்கிளை" {
+		t.Errorf("Join %s + %s (all rules), expected மரக்கிளை, got %s", split.Left, split.Right, joined)
+	}
+
+	// Without lexicon, the splits explained by a rule come first
+	splits := script.SplitCompound(script.MustDecode("மரக்கிளை"), nil)
+	found := false
+	for i, split := range splits {
+		if i > 0 && split.Rule != 0 && splits[i-1].Rule == 0 {
+			t.Errorf("Split மரக்கிளை without lexicon, expected the splits with rule first, got %v", splits)
+		}
+		if split.Left.String() == "மரம்" && split.Right.String() == "கிளை" {
+			found = true
+		}
+		if split.Right.FirstLetter().IsC() {
+			t.Errorf("Split மரக்கிளை, got %s + %s starting with consonant", split.Left, split.Right)
+		}
+	}
+	if !found {
+		t.Errorf("Split மரக்கிளை without lexicon, expected மரம் + கிளை, got %v", splits)
+	}
+}
@@ -0,0 +1,17 @@
+// Thamizh string (non-Unicode) encode error
+
+package script
+
+import "fmt"
+
+// Thamizh string encode error, locating the letter not representable in the target encoding
+type EncodeError struct {
+	Encoding     string // Target encoding name
+	LetterOffset int    // Letter offset (usable with LetterAt) of the offending letter
+	Letter       Letter // The offending letter
+}
+
+// Error interface implementation
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("letter %s (letter offset %d) not representable in %s encoding", e.Letter, e.LetterOffset, e.Encoding)
+}
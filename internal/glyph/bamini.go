@@ -0,0 +1,21 @@
+// Bamini (ASCII-mapped font) glyph encoding
+
+package glyph
+
+// Bamini font glyph encoding table
+//
+// Note: Covers the native letters except the ூ forms (and ஙு ஞு); ஜ ஸ are the only Grantha glyphs mapped.
+// The ள glyph ('s') doubles as the au length mark (after ெ/ஒ, unless followed by a vowel sign/dot glyph).
+var Bamini = NewTable("Bamini", map[byte]string{
+	'm': "அ", 'M': "ஆ", ',': "இ", '<': "ஈ", 'c': "உ", 'C': "ஊ",
+	'v': "எ", 'V': "ஏ", 'I': "ஐ", 'x': "ஒ", 'X': "ஓ", '/': "ஃ",
+	'f': "க", 'q': "ங", 'r': "ச", 'Q': "ஞ", 'l': "ட", 'z': "ண",
+	'j': "த", 'e': "ந", 'g': "ப", 'k': "ம", 'a': "ய", 'u': "ர",
+	'y': "ல", 't': "வ", 'o': "ழ", 's': "ள", 'w': "ற", 'd': "ன",
+	'[': "ஜ", ']': "ஸ",
+	'h': "ா", 'p': "ி", 'P': "ீ", 'n': "ெ", 'N': "ே", 'i': "ை", ';': "்",
+	'b': "டி", 'B': "டீ",
+	'F': "கு", 'R': "சு", 'L': "டு", 'Z': "ணு", 'J': "து", 'E': "நு",
+	'G': "பு", 'K': "மு", 'A': "யு", 'U': "ரு", 'Y': "லு", 'T': "வு",
+	'O': "ழு", 'S': "ளு", 'W': "று", 'D': "னு",
+}).WithAuAlias('s')
@@ -0,0 +1,193 @@
+// Glyph-based (visual order) 8-bit encodings
+// Glyph byte sequence <=> Thamizh Unicode (logical order) string <=> Thamizh letter-index sequence
+
+package glyph
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	letter "github.com/ThamizhLearner/Thamizh/internal"
+	"github.com/ThamizhLearner/Thamizh/internal/unicode"
+)
+
+// Vowel signs visually preceding the consonant (stored before the consonant glyph)
+const prefixSigns = "ெேை"
+
+// Glyph encoding table
+type Table struct {
+	Name    string
+	glyphs  [256]string     // Byte => Logical order Unicode fragment; "" for unmapped byte
+	prefix  [256]bool       // Prefix vowel sign glyph bytes
+	reverse map[string]byte // Logical order Unicode fragment => Byte (lowest byte for shared fragment)
+	auAlias int             // Byte standing for ௗ after ெ/ஒ (when the encoding has no ௗ glyph); -1 for none
+}
+
+// Creates glyph encoding table for the given byte => Unicode fragment mapping
+//
+// Unmapped ASCII bytes (0x00 - 0x7F) map to themselves.
+func NewTable(name string, glyphs map[byte]string) *Table {
+	t := &Table{Name: name, reverse: make(map[string]byte), auAlias: -1}
+	for b := 255; b >= 0; b-- { // Note: Reverse order for lowest byte to win
+		g, ok := glyphs[byte(b)]
+		if !ok && b < 0x80 {
+			g = string(rune(b))
+		}
+		if g == "" {
+			continue
+		}
+		t.glyphs[b] = g
+		t.prefix[b] = utf8.RuneCountInString(g) == 1 && strings.Contains(prefixSigns, g)
+		t.reverse[g] = byte(b)
+	}
+	return t
+}
+
+// Sets the byte standing for ௗ (au length mark) when following ெ (after reordering) or ஒ
+//
+// Note: Some font encodings reuse the ள glyph as the au length mark
+func (t *Table) WithAuAlias(b byte) *Table {
+	t.auAlias = int(b)
+	return t
+}
+
+// Converts the given glyph byte sequence into logical order (composed) Unicode string
+//
+// Unmapped bytes are converted to utf8.RuneError.
+func (t *Table) ToUnicode(b []byte) string {
+	ustr, _ := t.toUnicode(b)
+	return unicode.Compose(ustr)
+}
+
+// Converts the given glyph byte sequence into logical order (uncomposed) Unicode string
+//
+// Also returns the (source) byte offset of each rune.
+func (t *Table) toUnicode(b []byte) (string, []int) {
+	var sb strings.Builder
+	var offsets []int
+	write := func(g string, offset int) {
+		for _, r := range g {
+			sb.WriteRune(r)
+			offsets = append(offsets, offset)
+		}
+	}
+	glyph := func(i int) string {
+		if g := t.glyphs[b[i]]; g != "" {
+			return g
+		}
+		return string(utf8.RuneError)
+	}
+	for i := 0; i < len(b); i++ {
+		last := glyph(i) // Glyph written last
+		// Prefix vowel sign moves after the (immediately following) consonant glyph
+		if t.prefix[b[i]] && i+1 < len(b) && !t.prefix[b[i+1]] {
+			write(glyph(i+1), i+1)
+			write(last, i)
+			i++
+		} else {
+			write(last, i)
+		}
+		if (last == "ெ" || last == "ஒ") && t.isAuAlias(b[i+1:]) {
+			i++
+			write(string(unicode.UAuMark), i)
+		}
+	}
+	return sb.String(), offsets
+}
+
+// Indicates the au length mark alias (at the start of the given bytes)
+//
+// Note: The alias not followed by a vowel sign/dot glyph (which needs the alias as consonant) is taken as au length mark
+func (t *Table) isAuAlias(b []byte) bool {
+	if len(b) == 0 || int(b[0]) != t.auAlias {
+		return false
+	}
+	if len(b) == 1 {
+		return true
+	}
+	next := t.glyphs[b[1]]
+	return t.prefix[b[1]] || !unicode.IsValid("க"+next) || unicode.IsValid(next)
+}
+
+// Decodes the given glyph byte sequence
+//
+// On failure, returns the decode error locating the offending byte (Rune holds the byte value)
+func (t *Table) Decode(b []byte) ([]uint16, *unicode.DecodeError) {
+	ustr, offsets := t.toUnicode(b)
+	idxs, err := unicode.DecodeErr(ustr) // Note: Decoder accepts uncomposed two-part vowel signs
+	if err == nil {
+		return idxs, nil
+	}
+	if err.Reason == unicode.ErrEmptyString {
+		return nil, err
+	}
+	offset := offsets[err.RuneOffset]
+	return nil, &unicode.DecodeError{ByteOffset: offset, RuneOffset: offset, Rune: rune(b[offset]), Reason: err.Reason}
+}
+
+// Appends the glyph bytes of the given letter; false for letter not representable in this encoding
+func (t *Table) AppendLetter(b []byte, idx uint16) ([]byte, bool) {
+	if g, ok := t.reverse[unicode.EncodeLetter(idx)]; ok {
+		return append(b, g), true
+	}
+	switch {
+	case letter.IsV(idx):
+		return t.appendParts(b, "", unicode.Decompose(unicode.EncodeLetter(idx)))
+	case idx == letter.SriIdx:
+		lead, rest, _ := letter.SplitConjunct(idx)
+		if b2, ok := t.AppendLetter(b, lead); ok {
+			return t.AppendLetter(b2, rest)
+		}
+	case letter.IsC(idx):
+		row := letter.CRow(idx)
+		return t.appendParts(b, unicode.EncodeLetter(letter.CVIdx(row, 0)), string(unicode.UDot))
+	case letter.IsCV(idx):
+		row, _ := letter.CVRowCol(idx)
+		consonant := unicode.EncodeLetter(letter.CVIdx(row, 0))
+		sign := strings.TrimPrefix(unicode.EncodeLetter(idx), consonant)
+		return t.appendParts(b, consonant, unicode.Decompose(sign))
+	}
+	return b, false
+}
+
+// Appends base consonant glyph (if any) along with the prefix/suffix glyphs of the given (decomposed) signs
+func (t *Table) appendParts(b []byte, consonant string, signs string) ([]byte, bool) {
+	var pre, post []byte
+	for _, r := range signs {
+		g, ok := t.reverse[string(r)]
+		if !ok && r == unicode.UAuMark && t.auAlias >= 0 {
+			g, ok = byte(t.auAlias), true
+		}
+		if !ok {
+			return b, false
+		}
+		if t.prefix[g] {
+			pre = append(pre, g)
+		} else {
+			post = append(post, g)
+		}
+	}
+	if consonant != "" {
+		g, ok := t.reverse[consonant]
+		if !ok {
+			return b, false
+		}
+		pre = append(pre, g)
+	}
+	b = append(b, pre...)
+	return append(b, post...), true
+}
+
+// Encodes the given letter sequence
+//
+// On failure, returns the offset of the first letter not representable in this encoding
+func (t *Table) Encode(idxs []uint16) ([]byte, int) {
+	var b []byte
+	for i, idx := range idxs {
+		var ok bool
+		if b, ok = t.AppendLetter(b, idx); !ok {
+			return nil, i
+		}
+	}
+	return b, -1
+}
@@ -0,0 +1,32 @@
+// TSCII 1.7 (Tamil Standard Code for Information Interchange) glyph encoding
+
+package glyph
+
+// TSCII 1.7 glyph encoding table
+//
+// Note: Bytes 0x00 - 0x7F are ASCII
+var TSCII = NewTable("TSCII 1.7", map[byte]string{
+	0x82: "ஸ்ரீ",
+	0x83: "ஜ", 0x84: "ஷ", 0x85: "ஸ", 0x86: "ஹ", 0x87: "க்ஷ",
+	0x88: "ஜ்", 0x89: "ஷ்", 0x8A: "ஸ்", 0x8B: "ஹ்", 0x8C: "க்ஷ்",
+	0x91: "‘", 0x92: "’", 0x93: "“", 0x94: "”",
+	0x99: "ஙு", 0x9A: "ஞு", 0x9B: "ஙூ", 0x9C: "ஞூ",
+	0xA1: "ா", 0xA2: "ி", 0xA3: "ீ", 0xA4: "ு", 0xA5: "ூ",
+	0xA6: "ெ", 0xA7: "ே", 0xA8: "ை", 0xA9: "©", 0xAA: "ௗ",
+	0xAB: "அ", 0xAC: "ஆ", 0xAD: "இ", 0xAE: "ஈ", 0xAF: "உ", 0xB0: "ஊ",
+	0xB1: "எ", 0xB2: "ஏ", 0xB3: "ஐ", 0xB4: "ஒ", 0xB5: "ஓ", 0xB6: "ஔ",
+	0xB7: "ஃ",
+	0xB8: "க", 0xB9: "ங", 0xBA: "ச", 0xBB: "ஞ", 0xBC: "ட", 0xBD: "ண",
+	0xBE: "த", 0xBF: "ந", 0xC0: "ப", 0xC1: "ம", 0xC2: "ய", 0xC3: "ர",
+	0xC4: "ல", 0xC5: "வ", 0xC6: "ழ", 0xC7: "ள", 0xC8: "ற", 0xC9: "ன",
+	0xCA: "டி", 0xCB: "டீ",
+	0xCC: "கு", 0xCD: "சு", 0xCE: "டு", 0xCF: "ணு", 0xD0: "து", 0xD1: "நு",
+	0xD2: "பு", 0xD3: "மு", 0xD4: "யு", 0xD5: "ரு", 0xD6: "லு", 0xD7: "வு",
+	0xD8: "ழு", 0xD9: "ளு", 0xDA: "று", 0xDB: "னு",
+	0xDC: "கூ", 0xDD: "சூ", 0xDE: "டூ", 0xDF: "ணூ", 0xE0: "தூ", 0xE1: "நூ",
+	0xE2: "பூ", 0xE3: "மூ", 0xE4: "யூ", 0xE5: "ரூ", 0xE6: "லூ", 0xE7: "வூ",
+	0xE8: "ழூ", 0xE9: "ளூ", 0xEA: "றூ", 0xEB: "னூ",
+	0xEC: "க்", 0xED: "ங்", 0xEE: "ச்", 0xEF: "ஞ்", 0xF0: "ட்", 0xF1: "ண்",
+	0xF2: "த்", 0xF3: "ந்", 0xF4: "ப்", 0xF5: "ம்", 0xF6: "ய்", 0xF7: "ர்",
+	0xF8: "ல்", 0xF9: "வ்", 0xFA: "ழ்", 0xFB: "ள்", 0xFC: "ற்", 0xFD: "ன்",
+})
@@ -0,0 +1,138 @@
+// Thamizh letter index space layout
+
+package base
+
+/*
+	Letter index allocation:
+	  0 -  11 : 12 vowels (உயிர் எழுத்துகள்)
+	 12 -  29 : 18 consonants (மெய் எழுத்துகள்)
+	 30 - 245 : 216 vowelized consonants (உயிர் மெய் எழுத்துகள்)
+	246 - 251 : 6 Grantha consonants (ஜ் ஶ் ஷ் ஸ் ஹ் க்ஷ்)
+	252 - 323 : 72 Grantha vowelized consonants
+	      324 : ஸ்ரீ (Grantha conjunct)
+	      325 : ஃ (Aytham)
+
+	Consonant rows 0 - 17 are native, rows 18 - 23 are Grantha.
+*/
+
+const (
+	VowelCount            = 12
+	NativeConsonantCount  = 18
+	GranthaConsonantCount = 6
+	ConsonantCount        = NativeConsonantCount + GranthaConsonantCount // Consonant row count
+
+	CBase         uint16 = VowelCount                                       // First native consonant
+	CVBase        uint16 = CBase + NativeConsonantCount                     // First native vowelized consonant
+	GranthaCBase  uint16 = CVBase + NativeConsonantCount*VowelCount         // First Grantha consonant
+	GranthaCVBase uint16 = GranthaCBase + GranthaConsonantCount             // First Grantha vowelized consonant
+	SriIdx        uint16 = GranthaCVBase + GranthaConsonantCount*VowelCount // ஸ்ரீ
+	AythamIdx     uint16 = SriIdx + 1                                       // ஃ
+	LetterCount   uint16 = AythamIdx + 1                                    // Count of all letters
+)
+
+// Consonant rows of special interest
+const (
+	RowKa  uint8 = 0                        // க்
+	RowCa  uint8 = 2                        // ச்
+	RowTa  uint8 = 6                        // த்
+	RowNa  uint8 = 7                        // ந்
+	RowMa  uint8 = 9                        // ம்
+	RowYa  uint8 = 10                       // ய்
+	RowRa  uint8 = 11                       // ர்
+	RowLa  uint8 = 12                       // ல்
+	RowVa  uint8 = 13                       // வ்
+	RowLla uint8 = 15                       // ள்
+	RowRra uint8 = 16                       // ற்
+	RowNnn uint8 = 17                       // ன்
+	RowSsa uint8 = NativeConsonantCount + 2 // ஷ்
+	RowSa  uint8 = NativeConsonantCount + 3 // ஸ்
+	RowKss uint8 = NativeConsonantCount + 5 // க்ஷ்
+)
+
+// Vowel columns of special interest
+const (
+	ColII uint8 = 3 // ஈ
+	ColU  uint8 = 4 // உ
+)
+
+// Consonant letter index of the given consonant row
+func CIdx(row uint8) uint16 {
+	if row < NativeConsonantCount {
+		return CBase + uint16(row)
+	}
+	return GranthaCBase + uint16(row-NativeConsonantCount)
+}
+
+// Vowelized-consonant letter index of the given consonant row and vowel column
+func CVIdx(row, col uint8) uint16 {
+	if row < NativeConsonantCount {
+		return CVBase + uint16(row)*VowelCount + uint16(col)
+	}
+	return GranthaCVBase + uint16(row-NativeConsonantCount)*VowelCount + uint16(col)
+}
+
+func IsV(idx uint16) bool { return idx < CBase }
+
+func IsC(idx uint16) bool {
+	return (idx >= CBase && idx < CVBase) || (idx >= GranthaCBase && idx < GranthaCVBase)
+}
+
+func IsCV(idx uint16) bool {
+	return (idx >= CVBase && idx < GranthaCBase) || (idx >= GranthaCVBase && idx < SriIdx)
+}
+
+func IsGrantha(idx uint16) bool { return idx >= GranthaCBase && idx <= SriIdx }
+
+func IsAytham(idx uint16) bool { return idx == AythamIdx }
+
+// Consonant row of the given consonant letter index
+func CRow(idx uint16) uint8 {
+	if idx < CVBase {
+		return uint8(idx - CBase)
+	}
+	return NativeConsonantCount + uint8(idx-GranthaCBase)
+}
+
+// Consonant row and vowel column of the given vowelized-consonant letter index
+func CVRowCol(idx uint16) (row, col uint8) {
+	if idx < GranthaCBase {
+		idx -= CVBase
+		return uint8(idx / VowelCount), uint8(idx % VowelCount)
+	}
+	idx -= GranthaCVBase
+	return NativeConsonantCount + uint8(idx/VowelCount), uint8(idx % VowelCount)
+}
+
+// Splits Grantha conjunct letter into its leading consonant and the rest
+//
+// க்ஷ-row letter => க் + ஷ-row letter; ஸ்ரீ => ஸ் + ரீ
+func SplitConjunct(idx uint16) (lead, rest uint16, ok bool) {
+	switch {
+	case idx == SriIdx:
+		return CIdx(RowSa), CVIdx(RowRa, ColII), true
+	case idx == CIdx(RowKss):
+		return CIdx(RowKa), CIdx(RowSsa), true
+	case IsCV(idx):
+		if row, col := CVRowCol(idx); row == RowKss {
+			return CIdx(RowKa), CVIdx(RowSsa, col), true
+		}
+	}
+	return idx, idx, false
+}
+
+// Joins leading consonant and the rest into Grantha conjunct letter (inverse of SplitConjunct)
+func JoinConjunct(lead, rest uint16) (uint16, bool) {
+	switch {
+	case lead == CIdx(RowSa) && rest == CVIdx(RowRa, ColII):
+		return SriIdx, true
+	case lead != CIdx(RowKa):
+		return lead, false
+	case rest == CIdx(RowSsa):
+		return CIdx(RowKss), true
+	case IsCV(rest):
+		if row, col := CVRowCol(rest); row == RowSsa {
+			return CVIdx(RowKss, col), true
+		}
+	}
+	return lead, false
+}
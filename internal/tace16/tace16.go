@@ -0,0 +1,94 @@
+// TACE16 (Tamil All Character Encoding) code mapping
+// Thamizh letter-index <=> TACE16 (16-bit, private use area) code
+
+package tace16
+
+import (
+	letter "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+/*
+	TACE16 letter code layout (one code per letter):
+	0xE180         : ஃ
+	0xE181 - 0xE18C : 12 vowels (அ - ஔ)
+	0xE190 + 0x10*r : Consonant of row r (e.g. 0xE190 => க்)
+	0xE191 + 0x10*r + v : Vowelized consonant of row r and vowel v (e.g. 0xE191 => க)
+
+	Rows 0 - 17 are native consonants (க - ன); rows 18 - 22 are Grantha consonants (ஜ ஷ ஸ ஹ க்ஷ).
+	ஶ based letters have no TACE16 code, while ஸ்ரீ is encoded as ஸ் + ரீ.
+*/
+
+const (
+	AythamCode    uint16 = 0xE180
+	VowelBase     uint16 = 0xE181
+	ConsonantBase uint16 = 0xE190
+	RowSize       uint16 = 0x10
+)
+
+// TACE16 row of each Grantha consonant row (ஜ ஶ ஷ ஸ ஹ க்ஷ); 0 for no TACE16 row
+var granthaRows = [letter.GranthaConsonantCount]uint16{18, 0, 19, 20, 21, 22}
+
+// Letter index => TACE16 code; 0 for letter without TACE16 code
+var letterCodes [letter.LetterCount]uint16 = func() [letter.LetterCount]uint16 {
+	var codes [letter.LetterCount]uint16
+	codes[letter.AythamIdx] = AythamCode
+	for v := range letter.VowelCount {
+		codes[v] = VowelBase + uint16(v)
+	}
+	for r := range letter.ConsonantCount {
+		row := uint16(r)
+		if r >= letter.NativeConsonantCount {
+			row = granthaRows[r-letter.NativeConsonantCount]
+			if row == 0 {
+				continue
+			}
+		}
+		rowBase := ConsonantBase + row*RowSize
+		codes[letter.CIdx(uint8(r))] = rowBase
+		for v := range letter.VowelCount {
+			codes[letter.CVIdx(uint8(r), uint8(v))] = rowBase + 1 + uint16(v)
+		}
+	}
+	return codes
+}()
+
+// TACE16 code => letter index
+var codeLetters map[uint16]uint16 = func() map[uint16]uint16 {
+	letters := make(map[uint16]uint16)
+	for idx, code := range letterCodes {
+		if code != 0 {
+			letters[code] = uint16(idx)
+		}
+	}
+	return letters
+}()
+
+// Appends TACE16 code(s) of the given letter; false for letter without TACE16 code
+func AppendCodes(codes []uint16, idx uint16) ([]uint16, bool) {
+	if idx == letter.SriIdx {
+		lead, rest, _ := letter.SplitConjunct(idx)
+		return append(codes, letterCodes[lead], letterCodes[rest]), true
+	}
+	code := letterCodes[idx]
+	if code == 0 {
+		return codes, false
+	}
+	return append(codes, code), true
+}
+
+// Appends the letter of the given TACE16 code, joining Grantha conjunct (க் + ஷ, ஸ் + ரீ) letters
+//
+// Returns false for code not corresponding to any letter
+func AppendLetter(idxs []uint16, code uint16) ([]uint16, bool) {
+	idx, ok := codeLetters[code]
+	if !ok {
+		return idxs, false
+	}
+	if last := len(idxs) - 1; last >= 0 {
+		if joined, ok := letter.JoinConjunct(idxs[last], idx); ok {
+			idxs[last] = joined
+			return idxs, true
+		}
+	}
+	return append(idxs, idx), true
+}
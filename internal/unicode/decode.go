@@ -2,6 +2,8 @@ package unicode
 
 import (
 	"fmt"
+
+	letter "github.com/ThamizhLearner/Thamizh/internal"
 )
 
 // Range check validation for the give Unicode code point rune
@@ -36,37 +38,121 @@ func DumpAnnotations(s string) {
 // Gets Thamizh letter slice by decoding given Unicode string
 //
 // Returns nil on invalid Unicode string
-func Decode(s string) []uint8 {
+func Decode(s string) []uint16 {
+	idxs, err := DecodeErr(s)
+	if err != nil {
+		return nil
+	}
+	return idxs
+}
+
+// Indicates structurally valid Thamizh Unicode string
+func IsValid(s string) bool {
+	_, err := DecodeErr(s)
+	return err == nil
+}
+
+// Gets Thamizh letter slice by decoding given Unicode string
+//
+// Returns the decode error (locating the offending code point) on invalid Unicode string
+func DecodeErr(s string) ([]uint16, *DecodeError) {
+	return decodeAnnotated(s, getAnnotation, composeVowel)
+}
+
+// Gets letter slice by decoding given Unicode string, as per the given code point annotation
+//
+// Note: compose gets the composed vowel (sign) column for the decomposed two-part vowel (sign) pair
+func decodeAnnotated(s string, annotate func(rune) annoCode, compose func(prev, curr annoCode) (uint8, bool)) ([]uint16, *DecodeError) {
 	// Only the BaseConsonant may be followed by atmost one AttachedDot/AttachedVowel
+	// Aytham is a standalone letter (like PrimaryVowel)
 	prev := annoCode{}
-	var idxs []uint8
-	for _, curr := range getAnnotations(s) {
+	var idxs []uint16
+	runeOffset := -1
+	for byteOffset, r := range s {
+		runeOffset++
+		curr := annotate(r)
 		switch curr.group {
 		case tBaseConsonant:
 			if prev.group == tBaseConsonant {
-				idxs = append(idxs, 30+prev.idx*12)
+				idxs = append(idxs, letter.CVIdx(prev.idx, 0))
 			}
+			idxs, curr = joinKssa(idxs, curr)
 		case tPrimaryVowel:
 			if prev.group == tBaseConsonant {
-				idxs = append(idxs, 30+prev.idx*12)
+				idxs = append(idxs, letter.CVIdx(prev.idx, 0))
+			}
+			idxs = append(idxs, uint16(curr.idx))
+		case tAytham:
+			if prev.group == tBaseConsonant {
+				idxs = append(idxs, letter.CVIdx(prev.idx, 0))
 			}
-			idxs = append(idxs, curr.idx)
+			idxs = append(idxs, letter.AythamIdx)
+		case tLengthMark:
+			col, ok := compose(prev, curr)
+			if !ok {
+				return nil, &DecodeError{byteOffset, runeOffset, r, ErrStrayLengthMark}
+			}
+			idxs, curr = recomposeLast(idxs, prev, col)
 		case tDetachedVowel, tDetachedDot:
+			if col, ok := compose(prev, curr); ok {
+				idxs, curr = recomposeLast(idxs, prev, col) // Decomposed two-part vowel sign
+				break
+			}
 			if prev.group != tBaseConsonant {
-				return nil // Invalid input: Missing base-consonant
+				// Invalid input: Missing base-consonant
+				return nil, &DecodeError{byteOffset, runeOffset, r, detachedCodeErrReason(prev, curr)}
 			}
 			if curr.group == tDetachedDot {
-				idxs = append(idxs, 12+prev.idx)
+				idxs = append(idxs, letter.CIdx(prev.idx))
 			} else {
-				idxs = append(idxs, 30+prev.idx*12+curr.idx)
+				idxs = appendCV(idxs, prev.idx, curr.idx)
 			}
 		case tNone:
-			return nil // Invalid input: Not-supported code-point annotation
+			// Invalid input: Not-supported code-point annotation
+			return nil, &DecodeError{byteOffset, runeOffset, r, ErrUnsupportedCode}
 		}
 		prev = curr
 	}
 	if prev.group == tBaseConsonant {
-		idxs = append(idxs, 30+prev.idx*12)
+		idxs = append(idxs, letter.CVIdx(prev.idx, 0))
 	}
-	return idxs
+	if idxs == nil {
+		return nil, &DecodeError{Reason: ErrEmptyString}
+	}
+	return idxs, nil
+}
+
+// Reason for the detached (vowel/dot) code point missing its base-consonant
+func detachedCodeErrReason(prev, curr annoCode) DecodeErrReason {
+	switch {
+	case curr.group == tDetachedVowel && prev.group == tDetachedVowel:
+		return ErrDoubleVowelSign
+	case curr.group == tDetachedDot && (prev.group == tDetachedVowel || prev.group == tPrimaryVowel):
+		return ErrDotAfterVowel
+	case curr.group == tDetachedDot:
+		return ErrDotWithoutBaseConsonant
+	default:
+		return ErrVowelSignWithoutBaseConsonant
+	}
+}
+
+// Merges க் (already decoded) with the immediately following ஷ base-consonant into the க்ஷ base-consonant
+//
+// Note: க் is the last decoded letter only when the dot immediately precedes the current base-consonant
+func joinKssa(idxs []uint16, curr annoCode) ([]uint16, annoCode) {
+	last := len(idxs) - 1
+	if curr.idx != letter.RowSsa || last < 0 || idxs[last] != letter.CIdx(letter.RowKa) {
+		return idxs, curr
+	}
+	return idxs[:last], annoCode{group: tBaseConsonant, idx: letter.RowKss}
+}
+
+// Appends the vowelized-consonant letter, merging ஸ் + ரீ into the ஸ்ரீ conjunct letter
+func appendCV(idxs []uint16, row, col uint8) []uint16 {
+	last := len(idxs) - 1
+	if row == letter.RowRa && col == letter.ColII && last >= 0 && idxs[last] == letter.CIdx(letter.RowSa) {
+		idxs[last] = letter.SriIdx
+		return idxs
+	}
+	return append(idxs, letter.CVIdx(row, col))
 }
@@ -0,0 +1,71 @@
+// Thamizh Unicode string decode error
+
+package unicode
+
+import "fmt"
+
+// Reason for Thamizh Unicode string decode failure
+type DecodeErrReason uint8
+
+// Thamizh Unicode string decode failure reasons
+const (
+	// Code point not supported (not a Thamizh letter code point)
+	ErrUnsupportedCode DecodeErrReason = iota + 1
+
+	// Vowel sign (உயிர்க்குறி) without preceding base-consonant
+	ErrVowelSignWithoutBaseConsonant
+
+	// Dot (புள்ளி) following a vowel or vowel sign
+	ErrDotAfterVowel
+
+	// Dot (புள்ளி) without preceding base-consonant (at start, or following dot/aytham)
+	ErrDotWithoutBaseConsonant
+
+	// Vowel sign following another vowel sign
+	ErrDoubleVowelSign
+
+	// Empty string (zero-length Thamizh string is not allowed)
+	ErrEmptyString
+
+	// Au length mark (ௗ) not completing a two-part ஔ/ௌ
+	ErrStrayLengthMark
+)
+
+// Implicit Stringer interface implementation
+func (r DecodeErrReason) String() string {
+	switch r {
+	case ErrUnsupportedCode:
+		return "unsupported code point"
+	case ErrVowelSignWithoutBaseConsonant:
+		return "vowel sign without base consonant"
+	case ErrDotAfterVowel:
+		return "pulli after vowel"
+	case ErrDotWithoutBaseConsonant:
+		return "pulli without base consonant"
+	case ErrDoubleVowelSign:
+		return "double vowel sign"
+	case ErrEmptyString:
+		return "empty string"
+	case ErrStrayLengthMark:
+		return "stray au length mark"
+	default:
+		return fmt.Sprintf("DecodeErrReason(%d)", uint8(r))
+	}
+}
+
+// Thamizh Unicode string decode error, locating the offending code point
+type DecodeError struct {
+	ByteOffset int             // Byte offset of the offending code point
+	RuneOffset int             // Rune (code point) offset of the offending code point
+	Rune       rune            // The offending code point
+	Reason     DecodeErrReason // Reason for the decode failure
+}
+
+// Error interface implementation
+func (e *DecodeError) Error() string {
+	if e.Reason == ErrEmptyString {
+		return "invalid Thamizh Unicode string: empty string"
+	}
+	return fmt.Sprintf("invalid Thamizh Unicode string: %v %U at byte offset %d (rune offset %d)",
+		e.Reason, e.Rune, e.ByteOffset, e.RuneOffset)
+}
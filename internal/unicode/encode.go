@@ -3,41 +3,52 @@ package unicode
 import (
 	"strings"
 
+	letter "github.com/ThamizhLearner/Thamizh/internal"
 	base "github.com/ThamizhLearner/Thamizh/internal/unicode/internal"
 )
 
 const (
-	UDot rune = '\u0BCD' // Unicode Thamizh 'dot' (புள்ளி) code point
+	UDot    rune = '\u0BCD'               // Unicode Thamizh 'dot' (புள்ளி) code point
+	UAuMark rune = base.AuLengthMarkUCode // Unicode Thamizh au length mark code point
 )
 
+// Unicode encoded base-consonant string of each consonant row
+var baseConsonantUStrs [letter.ConsonantCount]string = func() [letter.ConsonantCount]string {
+	var strs [letter.ConsonantCount]string
+	for i, c := range base.BaseConsonantUCodes {
+		strs[i] = string(c)
+	}
+	for i, c := range base.GranthaConsonantUCodes {
+		strs[letter.NativeConsonantCount+i] = string(c)
+	}
+	strs[letter.RowKss] = base.KssaUStr
+	return strs
+}()
+
 // Letter index => Letter (Unicode encoded) string
-var letterEncodings [246]string = func() [246]string {
-	var strs [246]string
+var letterEncodings [letter.LetterCount]string = func() [letter.LetterCount]string {
+	var strs [letter.LetterCount]string
 	for i, v := range base.PrimaryVowelUCodes {
 		strs[i] = string(v)
 	}
 
-	runes := []rune{base.UNull, UDot}
-	for i, c := range base.BaseConsonantUCodes {
-		runes[0] = c
-		strs[12+i] = string(runes)
-	}
-	for ci, uc := range base.BaseConsonantUCodes {
-		rowBaseIdx := 30 + ci*12
-		strs[rowBaseIdx] = string(uc)
-		runes[0] = uc
+	for ci, uc := range baseConsonantUStrs {
+		row := uint8(ci)
+		strs[letter.CIdx(row)] = uc + string(UDot)
+		strs[letter.CVIdx(row, 0)] = uc
 		for ai, a := range base.AttachedVowelUCodes[1:] {
 			ai++ // Compensate for sub-slicing [1:]
-			runes[1] = a
-			strs[rowBaseIdx+ai] = string(runes)
+			strs[letter.CVIdx(row, uint8(ai))] = uc + string(a)
 		}
 	}
+	strs[letter.SriIdx] = base.SriUStr
+	strs[letter.AythamIdx] = string(base.AythamUCode)
 	return strs
 }()
 
-func EncodeLetter(idx uint8) string { return letterEncodings[idx] }
+func EncodeLetter(idx uint16) string { return letterEncodings[idx] }
 
-func Encode(idxs []uint8) string {
+func Encode(idxs []uint16) string {
 	var sb strings.Builder
 	for _, idx := range idxs {
 		sb.WriteString(letterEncodings[idx])
@@ -0,0 +1,146 @@
+// Indic script Unicode blocks, annotated along the Thamizh letter model
+
+package unicode
+
+import (
+	"strings"
+
+	letter "github.com/ThamizhLearner/Thamizh/internal"
+	base "github.com/ThamizhLearner/Thamizh/internal/unicode/internal"
+)
+
+const indicBlockSize = 128 // Code point count of an Indic script Unicode block
+
+// Indic script (Unicode-encoded) string <=> Thamizh letter-index sequence
+type IndicScript struct {
+	codes      *base.ScriptUCodes
+	annoCodes  [indicBlockSize]annoCode
+	letters    [letter.LetterCount]string
+	normalizer *strings.Replacer
+	sharedNa   bool // ந and ன share the base consonant
+}
+
+// Supported Indic scripts
+var (
+	Malayalam  = newIndicScript(&base.MalayalamUCodes)
+	Devanagari = newIndicScript(&base.DevanagariUCodes)
+	Telugu     = newIndicScript(&base.TeluguUCodes)
+	Kannada    = newIndicScript(&base.KannadaUCodes)
+)
+
+func newIndicScript(codes *base.ScriptUCodes) *IndicScript {
+	is := &IndicScript{codes: codes}
+	is.sharedNa = codes.BaseConsonants[letter.RowNa] == codes.BaseConsonants[letter.RowNnn]
+
+	// Annotations (the first consonant row wins the shared base consonant)
+	is.annoCodes[is.blockIdx(codes.Dot)] = annoCode{group: tDetachedDot}
+	is.annoCodes[is.blockIdx(codes.Aytham)] = annoCode{group: tAytham}
+	for i, v := range codes.PrimaryVowels {
+		is.annoCodes[is.blockIdx(v)] = annoCode{group: tPrimaryVowel, idx: uint8(i)}
+	}
+	for i, a := range codes.AttachedVowels[1:] {
+		i++ // Compensate for sub-slicing [1:]
+		is.annoCodes[is.blockIdx(a)] = annoCode{group: tDetachedVowel, idx: uint8(i)}
+	}
+	for i, c := range codes.BaseConsonants {
+		if is.annoCodes[is.blockIdx(c)].group == tNone {
+			is.annoCodes[is.blockIdx(c)] = annoCode{group: tBaseConsonant, idx: uint8(i)}
+		}
+	}
+
+	// Letter encodings
+	for i, v := range codes.PrimaryVowels {
+		is.letters[i] = string(v)
+	}
+	for row := range uint8(letter.ConsonantCount) {
+		var uc string
+		if row == letter.RowKss {
+			uc = string([]rune{codes.BaseConsonants[letter.RowKa], codes.Dot, codes.BaseConsonants[letter.RowSsa]})
+		} else {
+			uc = string(codes.BaseConsonants[row])
+		}
+		is.letters[letter.CIdx(row)] = uc + string(codes.Dot)
+		is.letters[letter.CVIdx(row, 0)] = uc
+		for ai, a := range codes.AttachedVowels[1:] {
+			ai++ // Compensate for sub-slicing [1:]
+			is.letters[letter.CVIdx(row, uint8(ai))] = uc + string(a)
+		}
+	}
+	is.letters[letter.SriIdx] = codes.Sri
+	is.letters[letter.AythamIdx] = string(codes.Aytham)
+
+	// The conventional ஸ்ரீ spelling (with ஶ) decodes into the ஸ்ரீ conjunct letter
+	sri := is.letters[letter.CIdx(letter.RowSa)] + is.letters[letter.CVIdx(letter.RowRa, letter.ColII)]
+	is.normalizer = strings.NewReplacer(append([]string{codes.Sri, sri}, codes.Normalizations...)...)
+	return is
+}
+
+// Note: Input must be a code point of the script's Unicode block
+func (is *IndicScript) blockIdx(r rune) int { return int(r - is.codes.BlockBase) }
+
+// Gets annotated code point coresponding to given rune
+func (is *IndicScript) annotation(r rune) annoCode {
+	if r >= is.codes.BlockBase && r < is.codes.BlockBase+indicBlockSize {
+		return is.annoCodes[is.blockIdx(r)]
+	}
+	return annoCode{}
+}
+
+func (is *IndicScript) Name() string { return is.codes.Name }
+
+func (is *IndicScript) EncodeLetter(idx uint16) string { return is.letters[idx] }
+
+func (is *IndicScript) Encode(idxs []uint16) string {
+	var sb strings.Builder
+	for _, idx := range idxs {
+		sb.WriteString(is.letters[idx])
+	}
+	return sb.String()
+}
+
+// Gets Thamizh letter slice by decoding given Unicode string of the script
+//
+// Equivalent code point sequences (decomposed vowel signs, chillus...) are normalized first;
+// the decode error offsets are within the normalized string.
+// On shared ந/ன base consonant, ந is taken word-initially and before த, ன otherwise.
+func (is *IndicScript) Decode(s string) ([]uint16, *DecodeError) {
+	idxs, err := decodeAnnotated(is.normalizer.Replace(s), is.annotation, noCompose)
+	if err != nil {
+		return nil, err
+	}
+	if is.sharedNa {
+		resolveNa(idxs)
+	}
+	return idxs, nil
+}
+
+// Decomposed two-part vowel signs are normalized before decoding
+func noCompose(prev, curr annoCode) (uint8, bool) { return 0, false }
+
+// Resolves the ந-row letters (decoded from the shared ந/ன base consonant) into ந or ன
+func resolveNa(idxs []uint16) {
+	for i, idx := range idxs[1:] {
+		i++ // Compensate for sub-slicing [1:]
+		if letter.IsC(idx) && letter.CRow(idx) == letter.RowNa {
+			if next := i + 1; next < len(idxs) && isTaRow(idxs[next]) {
+				continue // ந்த
+			}
+			idxs[i] = letter.CIdx(letter.RowNnn)
+		} else if letter.IsCV(idx) {
+			if row, col := letter.CVRowCol(idx); row == letter.RowNa {
+				idxs[i] = letter.CVIdx(letter.RowNnn, col)
+			}
+		}
+	}
+}
+
+func isTaRow(idx uint16) bool {
+	if letter.IsC(idx) {
+		return letter.CRow(idx) == letter.RowTa
+	}
+	if letter.IsCV(idx) {
+		row, _ := letter.CVRowCol(idx)
+		return row == letter.RowTa
+	}
+	return false
+}
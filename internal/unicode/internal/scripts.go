@@ -0,0 +1,153 @@
+// Unicode code points of the Indic scripts, laid out along the Thamizh letter model
+// Thamizh letter-index sequence <=> Indic script (Unicode-encoded) string
+
+package base
+
+// Unicode code points of an Indic script, laid out as the Thamizh tables above
+type ScriptUCodes struct {
+	Name      string
+	BlockBase rune // Start of the (128 code point) Unicode block
+
+	PrimaryVowels  [12]rune // அ - ஔ
+	AttachedVowels [12]rune // Vowel signs of ஆ - ஔ (UNull for அ)
+
+	// Base consonants of the consonant rows (க - ன, followed by ஜ ஶ ஷ ஸ ஹ)
+	//
+	// Note: The Grantha conjunct base-consonant க்ஷ is composed (க + virama + ஷ)
+	BaseConsonants [23]rune
+
+	Dot    rune   // Virama (புள்ளி)
+	Aytham rune   // Visarga, standing in for ஃ
+	Sri    string // Conventional spelling of ஸ்ரீ
+
+	// Equivalent code point sequences (decomposed vowel signs, chillus...) => the decodable form
+	//
+	// Note: Listed as strings.Replacer old/new pairs, longer sequence first
+	Normalizations []string
+}
+
+// Malayalam code points
+//
+// Note: ந and ன share the base consonant ന
+var MalayalamUCodes = ScriptUCodes{
+	Name:      "Malayalam",
+	BlockBase: 0x0D00,
+	PrimaryVowels: [12]rune{
+		'അ', 'ആ', 'ഇ', 'ഈ', 'ഉ', 'ഊ',
+		'എ', 'ഏ', 'ഐ', 'ഒ', 'ഓ', 'ഔ',
+	},
+	AttachedVowels: [12]rune{
+		UNull, 'ാ', 'ി', 'ീ', 'ു', 'ൂ',
+		'െ', 'േ', 'ൈ', 'ൊ', 'ോ', 'ൌ',
+	},
+	BaseConsonants: [23]rune{
+		'ക', 'ങ', 'ച', 'ഞ', 'ട', 'ണ',
+		'ത', 'ന', 'പ', 'മ', 'യ', 'ര',
+		'ല', 'വ', 'ഴ', 'ള', 'റ', 'ന',
+		'ജ', 'ശ', 'ഷ', 'സ', 'ഹ',
+	},
+	Dot:    '്',
+	Aytham: 'ഃ',
+	Sri:    "\u0D36\u0D4D\u0D30\u0D40", // ശ്രീ
+	Normalizations: []string{
+		"\u0D46\u0D3E", "\u0D4A", // െ + ാ => ൊ
+		"\u0D47\u0D3E", "\u0D4B", // േ + ാ => ോ
+		"\u0D46\u0D57", "\u0D4C", // െ + ൗ => ൌ
+		"\u0D57", "\u0D4C", // ൗ (modern au sign) => ൌ
+		"\u0D7A", "\u0D23\u0D4D", // ൺ => ണ്
+		"\u0D7B", "\u0D28\u0D4D", // ൻ => ന്
+		"\u0D7C", "\u0D30\u0D4D", // ർ => ര്
+		"\u0D7D", "\u0D32\u0D4D", // ൽ => ല്
+		"\u0D7E", "\u0D33\u0D4D", // ൾ => ള്
+		"\u0D7F", "\u0D15\u0D4D", // ൿ => ക്
+	},
+}
+
+// Devanagari code points
+var DevanagariUCodes = ScriptUCodes{
+	Name:      "Devanagari",
+	BlockBase: 0x0900,
+	PrimaryVowels: [12]rune{
+		'अ', 'आ', 'इ', 'ई', 'उ', 'ऊ',
+		'ऎ', 'ए', 'ऐ', 'ऒ', 'ओ', 'औ',
+	},
+	AttachedVowels: [12]rune{
+		UNull, 'ा', 'ि', 'ी', 'ु', 'ू',
+		'ॆ', 'े', 'ै', 'ॊ', 'ो', 'ौ',
+	},
+	BaseConsonants: [23]rune{
+		'क', 'ङ', 'च', 'ञ', 'ट', 'ण',
+		'त', 'न', 'प', 'म', 'य', 'र',
+		'ल', 'व', 'ऴ', 'ळ', 'ऱ', 'ऩ',
+		'ज', 'श', 'ष', 'स', 'ह',
+	},
+	Dot:    '्',
+	Aytham: 'ः',
+	Sri:    "\u0936\u094D\u0930\u0940", // श्री
+	Normalizations: []string{
+		"\u0933\u093C", "\u0934", // ळ + nukta => ऴ
+		"\u0930\u093C", "\u0931", // र + nukta => ऱ
+		"\u0928\u093C", "\u0929", // न + nukta => ऩ
+	},
+}
+
+// Telugu code points
+//
+// Note: ந and ன share the base consonant న
+var TeluguUCodes = ScriptUCodes{
+	Name:      "Telugu",
+	BlockBase: 0x0C00,
+	PrimaryVowels: [12]rune{
+		'అ', 'ఆ', 'ఇ', 'ఈ', 'ఉ', 'ఊ',
+		'ఎ', 'ఏ', 'ఐ', 'ఒ', 'ఓ', 'ఔ',
+	},
+	AttachedVowels: [12]rune{
+		UNull, 'ా', 'ి', 'ీ', 'ు', 'ూ',
+		'ె', 'ే', 'ై', 'ొ', 'ో', 'ౌ',
+	},
+	BaseConsonants: [23]rune{
+		'క', 'ఙ', 'చ', 'ఞ', 'ట', 'ణ',
+		'త', 'న', 'ప', 'మ', 'య', 'ర',
+		'ల', 'వ', 'ఴ', 'ళ', 'ఱ', 'న',
+		'జ', 'శ', 'ష', 'స', 'హ',
+	},
+	Dot:    '్',
+	Aytham: 'ః',
+	Sri:    "\u0C36\u0C4D\u0C30\u0C40", // శ్రీ
+	Normalizations: []string{
+		"\u0C46\u0C56", "\u0C48", // ె + ౖ => ై
+	},
+}
+
+// Kannada code points
+//
+// Note: ந and ன share the base consonant ನ
+var KannadaUCodes = ScriptUCodes{
+	Name:      "Kannada",
+	BlockBase: 0x0C80,
+	PrimaryVowels: [12]rune{
+		'ಅ', 'ಆ', 'ಇ', 'ಈ', 'ಉ', 'ಊ',
+		'ಎ', 'ಏ', 'ಐ', 'ಒ', 'ಓ', 'ಔ',
+	},
+	AttachedVowels: [12]rune{
+		UNull, 'ಾ', 'ಿ', 'ೀ', 'ು', 'ೂ',
+		'ೆ', 'ೇ', 'ೈ', 'ೊ', 'ೋ', 'ೌ',
+	},
+	BaseConsonants: [23]rune{
+		'ಕ', 'ಙ', 'ಚ', 'ಞ', 'ಟ', 'ಣ',
+		'ತ', 'ನ', 'ಪ', 'ಮ', 'ಯ', 'ರ',
+		'ಲ', 'ವ', 'ೞ', 'ಳ', 'ಱ', 'ನ',
+		'ಜ', 'ಶ', 'ಷ', 'ಸ', 'ಹ',
+	},
+	Dot:    '್',
+	Aytham: 'ಃ',
+	Sri:    "\u0CB6\u0CCD\u0CB0\u0CC0", // ಶ್ರೀ
+	Normalizations: []string{
+		"\u0CC6\u0CC2\u0CD5", "\u0CCB", // ೆ + ೂ + ೕ => ೋ
+		"\u0CCA\u0CD5", "\u0CCB", // ೊ + ೕ => ೋ
+		"\u0CC6\u0CC2", "\u0CCA", // ೆ + ೂ => ೊ
+		"\u0CC6\u0CD5", "\u0CC7", // ೆ + ೕ => ೇ
+		"\u0CC6\u0CD6", "\u0CC8", // ೆ + ೖ => ೈ
+		"\u0CBF\u0CD5", "\u0CC0", // ಿ + ೕ => ೀ
+	},
+}
@@ -25,3 +25,22 @@ var BaseConsonantUCodes = [18]rune{
 	'த', 'ந', 'ப', 'ம', 'ய', 'ர',
 	'ல', 'வ', 'ழ', 'ள', 'ற', 'ன',
 }
+
+// Unicode code point corresponding to Thamizh aytham (special) letter character
+const AythamUCode rune = 'ஃ'
+
+// Unicode code point corresponding to Thamizh au length mark (second part of decomposed ௌ/ஔ)
+const AuLengthMarkUCode rune = 'ௗ'
+
+// Unicode code points corresponding to Thamizh Grantha base-consonant (letter) characters
+//
+// Note: The Grantha conjunct base-consonant க்ஷ has no single code point (க + ் + ஷ)
+var GranthaConsonantUCodes = [5]rune{
+	'ஜ', 'ஶ', 'ஷ', 'ஸ', 'ஹ',
+}
+
+// Unicode code point sequence of the Grantha conjunct base-consonant க்ஷ
+const KssaUStr = "க்ஷ"
+
+// Unicode code point sequence of the Grantha conjunct letter ஸ்ரீ
+const SriUStr = "ஸ்ரீ"
@@ -0,0 +1,78 @@
+// Thamizh Unicode normalization (two-part vowel signs)
+
+package unicode
+
+import (
+	"strings"
+
+	letter "github.com/ThamizhLearner/Thamizh/internal"
+	base "github.com/ThamizhLearner/Thamizh/internal/unicode/internal"
+)
+
+// Canonical decompositions of the Thamizh two-part vowels and vowel signs
+var decompositions = [...]struct{ composed, first, second rune }{
+	{'\u0BCA', '\u0BC6', '\u0BBE'}, // ொ => ெ + ா
+	{'\u0BCB', '\u0BC7', '\u0BBE'}, // ோ => ே + ா
+	{'\u0BCC', '\u0BC6', '\u0BD7'}, // ௌ => ெ + ௗ
+	{'\u0B94', '\u0B92', '\u0BD7'}, // ஔ => ஒ + ௗ
+}
+
+var composer, decomposer = func() (*strings.Replacer, *strings.Replacer) {
+	var cPairs, dPairs []string
+	for _, d := range decompositions {
+		composed, decomposed := string(d.composed), string([]rune{d.first, d.second})
+		cPairs = append(cPairs, decomposed, composed)
+		dPairs = append(dPairs, composed, decomposed)
+	}
+	return strings.NewReplacer(cPairs...), strings.NewReplacer(dPairs...)
+}()
+
+// Composes the decomposed two-part vowels and vowel signs (NFC-style)
+//
+// Note: Only Thamizh code points are affected
+func Compose(s string) string { return composer.Replace(s) }
+
+// Decomposes the two-part vowels and vowel signs (NFD-style)
+//
+// Note: Only Thamizh code points are affected
+func Decompose(s string) string { return decomposer.Replace(s) }
+
+// Gets the annotated code point of the given annotation
+func annotatedRune(ac annoCode) rune {
+	switch ac.group {
+	case tPrimaryVowel:
+		return base.PrimaryVowelUCodes[ac.idx]
+	case tDetachedVowel:
+		return base.AttachedVowelUCodes[ac.idx]
+	case tLengthMark:
+		return base.AuLengthMarkUCode
+	default:
+		return base.UNull
+	}
+}
+
+// Gets the composed vowel (sign) column for the (prev, curr) decomposed vowel (sign) pair
+func composeVowel(prev, curr annoCode) (uint8, bool) {
+	first, second := annotatedRune(prev), annotatedRune(curr)
+	if prev.group == tLengthMark || curr.group == tPrimaryVowel {
+		return 0, false
+	}
+	for _, d := range decompositions {
+		if d.first == first && d.second == second {
+			return getAnnotation(d.composed).idx, true
+		}
+	}
+	return 0, false
+}
+
+// Replaces the last decoded letter (for prev annotation) with its composed vowel column form
+func recomposeLast(idxs []uint16, prev annoCode, col uint8) ([]uint16, annoCode) {
+	last := len(idxs) - 1
+	if prev.group == tPrimaryVowel {
+		idxs[last] = uint16(col)
+	} else {
+		row, _ := letter.CVRowCol(idxs[last])
+		idxs[last] = letter.CVIdx(row, col)
+	}
+	return idxs, annoCode{group: prev.group, idx: col}
+}
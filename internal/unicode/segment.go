@@ -0,0 +1,69 @@
+// Mixed-script text segmentation
+
+package unicode
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// Text run; either decoded Thamizh run or opaque (non-Thamizh) run
+type Run struct {
+	Start, End int      // Byte range [Start, End) within the text
+	Idxs       []uint16 // Decoded Thamizh letter slice; nil for opaque run
+}
+
+// Byte length of the maximal Thamizh code point run at the start of given string
+func thamizhRunLen(s string) int {
+	n := 0
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if getAnnotation(r).group == tNone {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// Iterator over the ordered runs making up the given (arbitrary UTF-8) text
+//
+// Decodable Thamizh code point runs yield Thamizh runs; everything else (including stray vowel signs/dots) yields opaque runs.
+// Adjacent opaque code points are combined into single run. The runs cover the text without gaps.
+func Runs(s string) iter.Seq[Run] {
+	return func(yield func(Run) bool) {
+		opaqueStart := 0 // Start of pending opaque run
+		i := 0
+		for i < len(s) {
+			n := thamizhRunLen(s[i:])
+			if n == 0 {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				i += size
+				continue
+			}
+			end := i + n
+			idxs, err := DecodeErr(s[i:end])
+			if err != nil {
+				end = i + err.ByteOffset // Decodable prefix ends at the offending code point
+				idxs = Decode(s[i:end])
+			}
+			if end > i {
+				if opaqueStart < i && !yield(Run{Start: opaqueStart, End: i}) {
+					return
+				}
+				if !yield(Run{Start: i, End: end, Idxs: idxs}) {
+					return
+				}
+				opaqueStart = end
+			}
+			i = end
+			if err != nil {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				i += size // Skip the offending code point (part of opaque run)
+			}
+		}
+		if opaqueStart < len(s) {
+			yield(Run{Start: opaqueStart, End: len(s)})
+		}
+	}
+}
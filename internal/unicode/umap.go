@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"strings"
 
+	letter "github.com/ThamizhLearner/Thamizh/internal"
 	base "github.com/ThamizhLearner/Thamizh/internal/unicode/internal"
 )
 
 const (
 	uBlockBase rune  = 0x0B80 // Start of Thamizh Unicode block
-	codesCount uint8 = 80     // Count of annotated code points
+	codesCount uint8 = 88     // Count of annotated code points
 	uBlockEnd  rune  = uBlockBase + rune(codesCount)
 )
 
@@ -23,12 +24,14 @@ const (
 	tPrimaryVowel                // Thamizh primary vowel code point group
 	tBaseConsonant               // Thamizh base-consonant code point group
 	tDetachedVowel               // Thamizh detached-vowel code point group
+	tAytham                      // Thamizh aytham code point group
+	tLengthMark                  // Thamizh au length mark code point group
 )
 
 // Annotated code points
 type annoCode struct {
 	group tGroup // Code group
-	idx   uint8  // Index within the code group
+	idx   uint8  // Index within the code group (consonant row for base-consonant group)
 }
 
 // Implicit Stringer interface implementation
@@ -51,10 +54,22 @@ func (ac annoCode) String() string {
 		sb.WriteString(fmt.Sprintf(" %c", base.PrimaryVowelUCodes[ac.idx]))
 	case tBaseConsonant:
 		sb.WriteString("BaseConsonant")
-		sb.WriteString(fmt.Sprintf(" %c", base.BaseConsonantUCodes[ac.idx]))
+		sb.WriteString(fmt.Sprintf(" %s", baseConsonantUStrs[ac.idx]))
 	case tDetachedVowel:
 		sb.WriteString("DetachedVowel")
 		sb.WriteString(fmt.Sprintf(" %c", base.AttachedVowelUCodes[ac.idx]))
+	case tAytham:
+		sb.WriteString("Aytham")
+		if ac.idx != 0 {
+			panic("Internal error")
+		}
+		sb.WriteString(fmt.Sprintf(" %c", base.AythamUCode))
+	case tLengthMark:
+		sb.WriteString("LengthMark")
+		if ac.idx != 0 {
+			panic("Internal error")
+		}
+		sb.WriteString(fmt.Sprintf(" %c", base.AuLengthMarkUCode))
 	default:
 		panic("")
 	}
@@ -69,6 +84,8 @@ func annoCodeIdx(r rune) uint8 { return uint8(r - uBlockBase) }
 var annotatedCodes [codesCount]annoCode = func() [codesCount]annoCode {
 	var annoCodes [codesCount]annoCode
 	annoCodes[annoCodeIdx(UDot)] = annoCode{group: tDetachedDot}
+	annoCodes[annoCodeIdx(base.AythamUCode)] = annoCode{group: tAytham}
+	annoCodes[annoCodeIdx(base.AuLengthMarkUCode)] = annoCode{group: tLengthMark}
 	for i, v := range base.PrimaryVowelUCodes {
 		annoCodes[annoCodeIdx(v)] = annoCode{group: tPrimaryVowel, idx: uint8(i)}
 	}
@@ -79,5 +96,8 @@ var annotatedCodes [codesCount]annoCode = func() [codesCount]annoCode {
 	for i, c := range base.BaseConsonantUCodes {
 		annoCodes[annoCodeIdx(c)] = annoCode{group: tBaseConsonant, idx: uint8(i)}
 	}
+	for i, c := range base.GranthaConsonantUCodes {
+		annoCodes[annoCodeIdx(c)] = annoCode{group: tBaseConsonant, idx: letter.NativeConsonantCount + uint8(i)}
+	}
 	return annoCodes
 }()
@@ -12,6 +12,9 @@ const (
 
 	// நெடில் எழுத்து; Long vocalization-duration vowel
 	Long
+
+	// அரை மாத்திரை எழுத்து; Half vocalization-duration (consonant and aytham) letter
+	Half
 )
 
 // Thamizh consonant vocalization-strength enum
@@ -0,0 +1,149 @@
+// Grapheme-to-phoneme (IPA) transcription
+
+package script
+
+import (
+	"strings"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// IPA transcription
+type IPATranscription struct {
+	Phonemic string // Broad (phonemic) transcription; one phoneme per letter component
+	Phonetic string // Narrow (phonetic) transcription; applying the positional allophony
+}
+
+// IPA phonemes of the 12 vowels (அ - ஔ)
+var ipaVowels = [base.VowelCount]string{
+	"a", "aː", "i", "iː", "u", "uː",
+	"e", "eː", "ai̯", "o", "oː", "au̯",
+}
+
+// IPA phonemes of the consonant rows (க - ன, followed by ஜ ஶ ஷ ஸ ஹ க்ஷ)
+var ipaConsonants = [base.ConsonantCount]string{
+	"k", "ŋ", "tʃ", "ɲ", "ʈ", "ɳ",
+	"t̪", "n̪", "p", "m", "j", "ɾ",
+	"l", "ʋ", "ɻ", "ɭ", "r", "n",
+	"dʒ", "ʃ", "ʂ", "s", "h", "kʂ",
+}
+
+// Voiced (after nasal/medium consonant) allophones of the strong consonant rows (க ச ட த ப)
+var ipaVoiced = map[uint8]string{0: "ɡ", 2: "dʒ", 4: "ɖ", 6: "d̪", 8: "b"}
+
+// Intervocalic allophones of the strong consonant rows (க ச ட த ப)
+var ipaIntervocalic = map[uint8]string{0: "x", 2: "s", 4: "ɖ", 6: "ð", 8: "β"}
+
+// Phoneme segment (of a letter component)
+type ipaSegment struct {
+	vowel bool
+	idx   uint8 // Vowel column, or consonant row
+	chr   Letter
+}
+
+// Splits the string into the phoneme segments
+func (s String) ipaSegments() []ipaSegment {
+	var segs []ipaSegment
+	var add func(chr Letter)
+	add = func(chr Letter) {
+		switch {
+		case chr.IsV():
+			segs = append(segs, ipaSegment{vowel: true, idx: uint8(chr.idx), chr: chr})
+		case chr.IsC():
+			segs = append(segs, ipaSegment{idx: base.CRow(chr.idx), chr: chr})
+		case chr.IsCV():
+			c, v := chr.SplitCV()
+			segs = append(segs, ipaSegment{idx: base.CRow(c.idx), chr: c}, ipaSegment{vowel: true, idx: uint8(v.idx), chr: v})
+		case chr.IsAytham():
+			segs = append(segs, ipaSegment{idx: 255, chr: chr})
+		default: // ஸ்ரீ
+			lead, rest, _ := base.SplitConjunct(chr.idx)
+			add(Letter{idx: lead})
+			add(Letter{idx: rest})
+		}
+	}
+	for chr := range s.Letters() {
+		add(chr)
+	}
+	return segs
+}
+
+// Transcribes the (single word) string into IPA
+//
+// Narrow transcription applies the positional allophony of the strong consonants (வல்லினம்):
+// voiceless word-initially and when geminated, voiced after nasal/medium consonants, and fricative/flap intervocalically
+// (e.g. க is [k] word-initially, [ɡ] after nasal, [x] intervocalically; ச is [s] word-initially and intervocalically).
+// Geminates are marked long (ː), ற்ற is [tːr], ன்ற is [ndr], ஃ is [x] before க and [h] otherwise,
+// and the word-final குற்றியலுகரம் (shortened உ) is [ɯ].
+func (s String) IPA() IPATranscription {
+	segs := s.ipaSegments()
+	var phonemic, phonetic strings.Builder
+	for i := 0; i < len(segs); i++ {
+		seg := segs[i]
+		if seg.vowel {
+			phonemic.WriteString(ipaVowels[seg.idx])
+			if seg.idx == base.ColU && i == len(segs)-1 && s.EndsWithKutriyalukaram() {
+				phonetic.WriteString("ɯ")
+			} else {
+				phonetic.WriteString(ipaVowels[seg.idx])
+			}
+			continue
+		}
+		if seg.chr.IsAytham() {
+			phonemic.WriteString("x")
+			if i+1 < len(segs) && !segs[i+1].vowel && segs[i+1].idx == 0 {
+				phonetic.WriteString("x")
+			} else {
+				phonetic.WriteString("h")
+			}
+			continue
+		}
+		phonemic.WriteString(ipaConsonants[seg.idx])
+		// Geminate consonant (C followed by same consonant)
+		if i+1 < len(segs) && !segs[i+1].vowel && segs[i+1].idx == seg.idx && seg.chr.IsC() {
+			phonemic.WriteString(ipaConsonants[seg.idx])
+			i++
+			switch seg.idx {
+			case base.RowRra:
+				phonetic.WriteString("tːr")
+			case base.RowCa:
+				phonetic.WriteString("tːʃ")
+			default:
+				phonetic.WriteString(ipaConsonants[seg.idx] + "ː")
+			}
+			continue
+		}
+		phonetic.WriteString(ipaAllophone(segs, i))
+	}
+	return IPATranscription{Phonemic: phonemic.String(), Phonetic: phonetic.String()}
+}
+
+// Gets the positional allophone of the (non-geminate) consonant segment at given position
+func ipaAllophone(segs []ipaSegment, i int) string {
+	seg := segs[i]
+	phoneme := ipaConsonants[seg.idx]
+	if i == 0 {
+		if seg.idx == base.RowCa {
+			return "s"
+		}
+		return phoneme
+	}
+	prev := segs[i-1]
+	if seg.idx == base.RowRra {
+		if !prev.vowel && prev.idx == base.RowNnn {
+			return "dr" // ன்ற
+		}
+		return phoneme
+	}
+	if !seg.chr.IsStrongVocal() {
+		return phoneme
+	}
+	switch {
+	case prev.vowel:
+		return ipaIntervocalic[seg.idx]
+	case prev.chr.IsMildVocal() || prev.chr.IsMediumVocal():
+		return ipaVoiced[seg.idx]
+	default:
+		return phoneme
+	}
+}
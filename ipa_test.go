@@ -0,0 +1,32 @@
+package script_test // Black box test
+
+import (
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestIPA(t *testing.T) {
+	tests := []struct {
+		ustr     string
+		phonemic string
+		phonetic string
+	}{
+		{"தமிழ்", "t̪amiɻ", "t̪amiɻ"},
+		{"பகல்", "pakal", "paxal"},
+		{"தங்கம்", "t̪aŋkam", "t̪aŋɡam"},
+		{"சட்டம்", "tʃaʈʈam", "saʈːam"},
+		{"பசு", "patʃu", "pasu"},
+		{"காற்று", "kaːrru", "kaːtːrɯ"},
+		{"நன்றி", "n̪anri", "n̪andri"},
+		{"அஃது", "axt̪u", "aht̪ɯ"},
+		{"பஞ்சு", "paɲtʃu", "paɲdʒɯ"},
+		{"பந்து", "pan̪t̪u", "pan̪d̪ɯ"},
+	}
+	for _, tc := range tests {
+		got := script.MustDecode(tc.ustr).IPA()
+		if got.Phonemic != tc.phonemic || got.Phonetic != tc.phonetic {
+			t.Errorf("IPA %s, expected /%s/ [%s], got /%s/ [%s]", tc.ustr, tc.phonemic, tc.phonetic, got.Phonemic, got.Phonetic)
+		}
+	}
+}
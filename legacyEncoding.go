@@ -0,0 +1,117 @@
+// Legacy glyph-based encoding converters
+// Visual order 8-bit encodings (TSCII) and ASCII-mapped font encodings (Bamini)
+
+package script
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ThamizhLearner/Thamizh/internal/glyph"
+)
+
+// Glyph-based (visual order) encoding; one byte per glyph
+//
+// Prefix vowel signs (ெ ே ை), stored before the consonant glyph, are reordered into the logical order.
+type GlyphEncoding struct {
+	t *glyph.Table
+}
+
+// Built-in glyph encodings
+//
+// Other fonts (e.g. TAM/TAB, Vanavil, Shreelipi) plug in through NewGlyphEncoding and RegisterGlyphEncoding.
+var (
+	// TSCII 1.7 (Tamil Standard Code for Information Interchange); ASCII bytes retained as is
+	TSCII = &GlyphEncoding{t: glyph.TSCII}
+
+	// Bamini ASCII-mapped font (e.g. 'f' => க); partial coverage, no ூ forms
+	Bamini = &GlyphEncoding{t: glyph.Bamini}
+)
+
+// Creates glyph encoding for the given byte => Unicode fragment (glyph) mapping
+//
+// Each Thamizh glyph must be a structurally valid Thamizh Unicode fragment; letters (e.g. "கு", "க்"), a vowel sign or the dot.
+// Unmapped ASCII bytes map to themselves.
+func NewGlyphEncoding(name string, glyphs map[byte]string) (*GlyphEncoding, error) {
+	for b, g := range glyphs {
+		if !validGlyph(g) {
+			return nil, fmt.Errorf("glyph encoding %s: byte 0x%02X: invalid glyph %q", name, b, g)
+		}
+	}
+	return &GlyphEncoding{t: glyph.NewTable(name, glyphs)}, nil
+}
+
+// Indicates valid glyph; Thamizh letters, single vowel sign/dot, or non-Thamizh symbols (e.g. quotes)
+func validGlyph(g string) bool {
+	isThamizh := func(r rune) bool { return r >= '\u0B80' && r <= '\u0BFF' }
+	if !strings.ContainsFunc(g, isThamizh) || IsValidThamizhUnicode(g) {
+		return true
+	}
+	return utf8.RuneCountInString(g) == 1 && (IsValidThamizhUnicode("க"+g) || IsValidThamizhUnicode("கெ"+g))
+}
+
+// Name of the encoding
+func (enc *GlyphEncoding) Name() string { return enc.t.Name }
+
+// Decodes the given glyph byte sequence
+//
+// On failure, returns *DecodeError locating the offending byte (Rune holds the byte value).
+func (enc *GlyphEncoding) Decode(b []byte) (String, error) {
+	idxs, err := enc.t.Decode(b)
+	if err != nil {
+		return String{}, err
+	}
+	return String{idxs: idxs}, nil
+}
+
+// Encodes the given string as glyph byte sequence (prefix vowel signs stored before the consonant glyph)
+//
+// On failure, returns *EncodeError locating the letter having no glyph representation
+func (enc *GlyphEncoding) Encode(s String) ([]byte, error) {
+	b, i := enc.t.Encode(s.idxs)
+	if i >= 0 {
+		return nil, &EncodeError{Encoding: enc.t.Name, LetterOffset: i, Letter: s.LetterAt(i)}
+	}
+	return b, nil
+}
+
+// Converts the given (mixed-script) glyph encoded text into (composed) Unicode text
+//
+// Unmapped bytes become U+FFFD. Use Segment to process the Thamizh runs.
+func (enc *GlyphEncoding) ToUnicode(b []byte) string { return enc.t.ToUnicode(b) }
+
+var glyphEncodings = struct {
+	sync.RWMutex
+	byName map[string]*GlyphEncoding
+}{byName: map[string]*GlyphEncoding{
+	TSCII.Name():  TSCII,
+	Bamini.Name(): Bamini,
+}}
+
+// Registers the given glyph encoding (by its name), replacing any earlier registration
+func RegisterGlyphEncoding(enc *GlyphEncoding) {
+	glyphEncodings.Lock()
+	defer glyphEncodings.Unlock()
+	glyphEncodings.byName[enc.Name()] = enc
+}
+
+// Looks up the registered glyph encoding by name
+func LookupGlyphEncoding(name string) (*GlyphEncoding, bool) {
+	glyphEncodings.RLock()
+	defer glyphEncodings.RUnlock()
+	enc, ok := glyphEncodings.byName[name]
+	return enc, ok
+}
+
+// Decodes the given TSCII 1.7 byte sequence
+func DecodeTSCII(b []byte) (String, error) { return TSCII.Decode(b) }
+
+// Encodes the given string as TSCII 1.7 byte sequence
+//
+// Note: ஶ based letters have no TSCII glyph (EncodeError)
+func EncodeTSCII(s String) ([]byte, error) { return TSCII.Encode(s) }
+
+// Converts the given TSCII 1.7 text into (composed) Unicode text; ASCII bytes are retained as is
+func TSCIIToUnicode(b []byte) string { return TSCII.ToUnicode(b) }
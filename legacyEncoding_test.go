@@ -0,0 +1,129 @@
+package script_test // Black box test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestTSCII(t *testing.T) {
+	tests := []struct {
+		ustr  string
+		tscii []byte
+	}{
+		{"தமிழ்", []byte{0xBE, 0xC1, 0xA2, 0xFA}},
+		{"கொ", []byte{0xA6, 0xB8, 0xA1}},
+		{"கோல", []byte{0xA7, 0xB8, 0xA1, 0xC4}},
+		{"கௌ", []byte{0xA6, 0xB8, 0xAA}},
+		{"வீடு", []byte{0xC5, 0xA3, 0xCE}},
+		{"டிஜு", []byte{0xCA, 0x83, 0xA4}},
+		{"ஸ்ரீ", []byte{0x82}},
+		{"லக்ஷ்மி", []byte{0xC4, 0x8C, 0xC1, 0xA2}},
+		{"அஃது", []byte{0xAB, 0xB7, 0xD0}},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		b, err := script.EncodeTSCII(s)
+		if err != nil || !bytes.Equal(b, tc.tscii) {
+			t.Errorf("TSCII encode %s, expected % X, got % X (%v)", tc.ustr, tc.tscii, b, err)
+		}
+		got, err := script.DecodeTSCII(tc.tscii)
+		if err != nil || got.String() != tc.ustr {
+			t.Errorf("TSCII decode % X, expected %s, got %s (%v)", tc.tscii, tc.ustr, got, err)
+		}
+	}
+
+	for _, ustr := range nativeLetterUStrs() {
+		s := script.MustDecode(ustr)
+		b, err := script.EncodeTSCII(s)
+		if err != nil {
+			t.Errorf("TSCII encode %s: %v", ustr, err)
+			continue
+		}
+		if got, err := script.DecodeTSCII(b); err != nil || got.String() != ustr {
+			t.Errorf("TSCII round trip %s, got %s (%v)", ustr, got, err)
+		}
+	}
+
+	if got := script.TSCIIToUnicode([]byte("(\xBE\xC1\xA2\xFA) \xA6\xB8\xA1")); got != "(தமிழ்) கொ" {
+		t.Errorf("TSCII to Unicode, got %s", got)
+	}
+
+	_, err := script.DecodeTSCII([]byte{0xB8, 0x20, 0xA1})
+	var decErr *script.DecodeError
+	if !errors.As(err, &decErr) || decErr.ByteOffset != 1 || decErr.Rune != ' ' {
+		t.Errorf("TSCII decode error, got %v", err)
+	}
+}
+
+func TestBamini(t *testing.T) {
+	tests := []struct {
+		ustr   string
+		bamini string
+	}{
+		{"தமிழ்", "jkpo;"},
+		{"கொல்", "nfhy;"},
+		{"கேள்", "Nfs;"},
+		{"தெளிவு", "njspT"},
+		{"கௌ", "nfs"},
+		{"ஔவை", "xsit"},
+		{"வீடு", "tPL"},
+		{"ஜன்னல்", "[d;dy;"},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		b, err := script.Bamini.Encode(s)
+		if err != nil || string(b) != tc.bamini {
+			t.Errorf("Bamini encode %s, expected %s, got %s (%v)", tc.ustr, tc.bamini, b, err)
+		}
+		got, err := script.Bamini.Decode([]byte(tc.bamini))
+		if err != nil || got.String() != tc.ustr {
+			t.Errorf("Bamini decode %s, expected %s, got %s (%v)", tc.bamini, tc.ustr, got, err)
+		}
+	}
+	// Every CV column round trips, but for the unmapped ூ forms (and ஙு ஞு)
+	for _, ustr := range nativeLetterUStrs() {
+		if strings.HasSuffix(ustr, "ூ") || ustr == "ஙு" || ustr == "ஞு" {
+			continue
+		}
+		s := script.MustDecode(ustr)
+		b, err := script.Bamini.Encode(s)
+		if err != nil {
+			t.Errorf("Bamini encode %s: %v", ustr, err)
+			continue
+		}
+		if got, err := script.Bamini.Decode(b); err != nil || got.String() != ustr {
+			t.Errorf("Bamini round trip %s, got %s (%v)", ustr, got, err)
+		}
+	}
+	if got := script.Bamini.ToUnicode([]byte("jkpo; 2")); got != "தமிழ் 2" {
+		t.Errorf("Bamini to Unicode, got %s", got)
+	}
+	if _, err := script.Bamini.Encode(script.MustDecode("கூ")); err == nil {
+		t.Errorf("Bamini encode error expected for unmapped letter")
+	}
+}
+
+func TestRegisterGlyphEncoding(t *testing.T) {
+	if _, err := script.NewGlyphEncoding("Bad", map[byte]string{'a': "ாா"}); err == nil {
+		t.Errorf("Expected invalid glyph error")
+	}
+	enc, err := script.NewGlyphEncoding("Toy", map[byte]string{'k': "க", 'a': "ா", 'e': "ெ", 'q': "‘"})
+	if err != nil {
+		t.Fatalf("New glyph encoding: %v", err)
+	}
+	script.RegisterGlyphEncoding(enc)
+	got, ok := script.LookupGlyphEncoding("Toy")
+	if !ok || got != enc {
+		t.Fatalf("Lookup glyph encoding")
+	}
+	if s, err := got.Decode([]byte("eka")); err != nil || s.String() != "கொ" {
+		t.Errorf("Toy decode, got %s (%v)", s, err)
+	}
+	if _, ok := script.LookupGlyphEncoding("TSCII 1.7"); !ok {
+		t.Errorf("Built-in TSCII lookup")
+	}
+}
@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"slices"
 
+	base "github.com/ThamizhLearner/Thamizh/internal"
 	"github.com/ThamizhLearner/Thamizh/internal/unicode"
 )
 
 // The Thamizh letter
 type Letter struct {
-	idx uint8
+	idx uint16
 }
 
 func MustNewLetter(ustr string) Letter {
@@ -23,17 +24,30 @@ func MustNewLetter(ustr string) Letter {
 	return letters[0]
 }
 
+// Single-letter string of the letter
+func (chr Letter) Str() String { return String{idxs: []uint16{chr.idx}} }
+
 // Indicates a Thamizh vowel letter (உயிர் எழுத்து)
-func (chr Letter) IsV() bool { return chr.idx < 12 }
+func (chr Letter) IsV() bool { return base.IsV(chr.idx) }
 
-// Indicates a Thamizh consonant letter (மெய் எழுத்து)
-func (chr Letter) IsC() bool { return chr.idx >= 12 && chr.idx < 30 }
+// Indicates a Thamizh consonant letter (மெய் எழுத்து), including Grantha consonants
+func (chr Letter) IsC() bool { return base.IsC(chr.idx) }
 
-// Indicates a Thamizh vowelized-consonant letter (உயிர் மெய் எழுத்து)
-func (chr Letter) IsCV() bool { return chr.idx >= 30 } // Note: No need for upper limit check!
+// Indicates a Thamizh vowelized-consonant letter (உயிர் மெய் எழுத்து), including Grantha vowelized consonants
+func (chr Letter) IsCV() bool { return base.IsCV(chr.idx) }
 
 // Indicates a Thamizh primary letter (முதல் எழுத்து)
-func (chr Letter) IsPrimary() bool { return chr.idx < 30 }
+func (chr Letter) IsPrimary() bool { return chr.idx < base.CVBase }
+
+// Indicates the Thamizh special letter aytham (ஆய்த எழுத்து; ஃ)
+//
+// Note: Aytham is a dependent letter (சார்பெழுத்து), neither V, C nor CV
+func (chr Letter) IsAytham() bool { return base.IsAytham(chr.idx) }
+
+// Indicates a Grantha letter (கிரந்த எழுத்து); ஜ ஶ ஷ ஸ ஹ க்ஷ based letters and ஸ்ரீ
+//
+// Note: The ஸ்ரீ conjunct letter is neither V, C nor CV
+func (chr Letter) IsGrantha() bool { return base.IsGrantha(chr.idx) }
 
 // Indicates if this letter has strong-strength vocalization characteric (வல்லின எழுத்து)
 func (chr Letter) IsStrongVocal() bool { return qBitFields[chr.idx]&bit_Strong != 0 }
@@ -50,6 +64,9 @@ func (chr Letter) IsShortVocal() bool { return qBitFields[chr.idx]&bit_Short !=
 // Indicates if this letter has long-duration vocalization characteric (நெடில் எழுத்து)
 func (chr Letter) IsLongVocal() bool { return qBitFields[chr.idx]&bit_Long != 0 }
 
+// Indicates if this letter has half-duration vocalization characteric (அரை மாத்திரை; consonant and aytham letters)
+func (chr Letter) IsHalfVocal() bool { return qBitFields[chr.idx]&bit_Half != 0 }
+
 // Indicates the letter is same as the specified letter
 func (chr Letter) Is(l Letter) bool { return chr.idx == l.idx }
 
@@ -70,8 +87,8 @@ func (cv Letter) SplitCV() (c Letter, v Letter) {
 	if !cv.IsCV() {
 		panic("expected CV letter")
 	}
-	cvIdx := cv.idx - 30
-	return Letter{idx: 12 + cvIdx/12}, Letter{idx: cvIdx % 12}
+	row, col := base.CVRowCol(cv.idx)
+	return Letter{idx: base.CIdx(row)}, Letter{idx: uint16(col)}
 }
 
 // Forms vowelized-consonant letter
@@ -79,7 +96,7 @@ func (c Letter) JoinCV(v Letter) Letter {
 	if !c.IsC() || !v.IsV() {
 		panic("expected C and V letters")
 	}
-	return Letter{idx: 30 + (c.idx-12)*12 + v.idx}
+	return Letter{idx: base.CVIdx(base.CRow(c.idx), uint8(v.idx))}
 }
 
 // Stringer interface implementation
@@ -15,6 +15,7 @@ const (
 	bit_Mild
 	bit_Short
 	bit_Long
+	bit_Half
 )
 
 func vocalStrengthQBit(vs base.VocalStrength) qBitField {
@@ -36,25 +37,35 @@ func vocalDurationQBit(vd base.VocalDuration) qBitField {
 		return bit_Short
 	case base.Long:
 		return bit_Long
+	case base.Half:
+		return bit_Half
 	default:
 		panic("Unexpected vocal duration value")
 	}
 }
 
-var qBitFields [246]qBitField = func() [246]qBitField {
-	var fields [246]qBitField
+var qBitFields [base.LetterCount]qBitField = func() [base.LetterCount]qBitField {
+	var fields [base.LetterCount]qBitField
 	for i, v := range base.VowelDurations {
 		fields[i] = vocalDurationQBit(v)
 	}
 	for i, c := range base.ConsonantStengths {
-		fields[12+i] = vocalStrengthQBit(c)
+		fields[base.CIdx(uint8(i))] = vocalStrengthQBit(c) | bit_Half
 	}
 	for ci, c := range base.ConsonantStengths {
-		offset := 30 + ci*12
 		vstrength := vocalStrengthQBit(c)
 		for vi, v := range base.VowelDurations {
-			fields[offset+vi] = vstrength | vocalDurationQBit(v)
+			fields[base.CVIdx(uint8(ci), uint8(vi))] = vstrength | vocalDurationQBit(v)
 		}
 	}
+	// Grantha consonants carry no (native) vocalization-strength classification
+	for ci := base.NativeConsonantCount; ci < base.ConsonantCount; ci++ {
+		fields[base.CIdx(uint8(ci))] = bit_Half
+		for vi, v := range base.VowelDurations {
+			fields[base.CVIdx(uint8(ci), uint8(vi))] = vocalDurationQBit(v)
+		}
+	}
+	fields[base.SriIdx] = bit_Long
+	fields[base.AythamIdx] = bit_Half
 	return fields
 }()
@@ -22,6 +22,14 @@ func TestLetterType(t *testing.T) {
 	}
 }
 
+func TestLetterStr(t *testing.T) {
+	for _, ustr := range []string{"அ", "க்", "கா", "க்ஷை", "ஸ்ரீ", "ஃ"} {
+		if got := script.MustNewLetter(ustr).Str(); got.Len() != 1 || got.String() != ustr {
+			t.Errorf("Str(%s), expected single-letter %s, got %s", ustr, ustr, got)
+		}
+	}
+}
+
 func TestLetterIs(t *testing.T) {
 	letters := slices.Collect(script.MustDecode("இல்").Letters())
 	if !letters[0].IsLetter("இ") {
@@ -63,3 +71,47 @@ func TestSplitJoinCV(t *testing.T) {
 		t.Errorf("Join CV")
 	}
 }
+
+func TestGrantha(t *testing.T) {
+	for _, l := range []string{"ஜ", "ஷ்", "ஸா", "ஹு", "ஶ", "க்ஷ", "க்ஷ்", "ஸ்ரீ"} {
+		if !script.MustNewLetter(l).IsGrantha() {
+			t.Errorf("Expected Grantha letter %s", l)
+		}
+	}
+	for _, l := range []string{"அ", "க்", "ன", "னௌ"} {
+		if script.MustNewLetter(l).IsGrantha() {
+			t.Errorf("Expected native letter %s", l)
+		}
+	}
+
+	cv := script.MustNewLetter("க்ஷோ")
+	c, v := cv.SplitCV()
+	if !c.IsLetter("க்ஷ்") || !v.IsLetter("ஓ") {
+		t.Errorf("Split Grantha CV")
+	}
+	if !c.JoinCV(v).Is(cv) {
+		t.Errorf("Join Grantha CV")
+	}
+
+	sri := script.MustNewLetter("ஸ்ரீ")
+	if sri.IsV() || sri.IsC() || sri.IsCV() || !sri.IsLongVocal() {
+		t.Errorf("ஸ்ரீ classification")
+	}
+	ja := script.MustNewLetter("ஜா")
+	if !ja.IsLongVocal() || ja.IsStrongVocal() || ja.IsMediumVocal() || ja.IsMildVocal() {
+		t.Errorf("Grantha CV vocalization")
+	}
+}
+
+func TestAytham(t *testing.T) {
+	aytham := script.MustNewLetter("ஃ")
+	if !aytham.IsAytham() || aytham.IsV() || aytham.IsC() || aytham.IsCV() || aytham.IsPrimary() || aytham.IsGrantha() {
+		t.Errorf("Aytham classification")
+	}
+	if !aytham.IsHalfVocal() || aytham.IsShortVocal() || aytham.IsLongVocal() {
+		t.Errorf("Aytham vocalization")
+	}
+	if !script.MustNewLetter("க்").IsHalfVocal() || script.MustNewLetter("க").IsHalfVocal() {
+		t.Errorf("Half vocal letter")
+	}
+}
@@ -0,0 +1,233 @@
+// Morphological analyzer; finite-state analysis of Thamizh words into root and tagged morphemes
+
+package morph
+
+import (
+	"slices"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+/*
+	Analysis runs right to left, as a finite-state walk over the suffix grammar:
+	1. Starting at the word end (StateEnd), each transition strips its morpheme off the remainder (TrimEnd);
+	   the morpheme may end inside a CV letter (படித்தாள் - ஆள் => படித்த்)
+	2. Before a vowel-initial morpheme, the morpheme's elided குற்றியலுகரம் is restored (கொண்ட் + இரு => கொண்டு)
+	3. At a root state, the remainder (or its repaired forms; பேச் => பேசு, மரத்த் => மரம்) is looked up in the lexicon
+*/
+
+// Root category
+type Category uint8
+
+const (
+	NounRoot Category = iota
+	VerbRoot
+)
+
+// Stringer interface implementation
+func (c Category) String() string {
+	switch c {
+	case NounRoot:
+		return "Noun"
+	case VerbRoot:
+		return "Verb"
+	default:
+		panic("Unexpected category value")
+	}
+}
+
+// Lexicon of roots; indicates the string is a root of the category
+type Lexicon func(root script.String, cat Category) bool
+
+// Lexicon of the given (Thamizh Unicode) noun and verb roots
+func NewLexicon(nouns, verbs []string) Lexicon {
+	roots := [...]map[string]bool{NounRoot: {}, VerbRoot: {}}
+	for _, n := range nouns {
+		roots[NounRoot][n] = true
+	}
+	for _, v := range verbs {
+		roots[VerbRoot][v] = true
+	}
+	return func(root script.String, cat Category) bool { return roots[cat][root.String()] }
+}
+
+// Morpheme tag
+type Tag string
+
+// Morpheme tags of the default grammar
+const (
+	TagPast             Tag = "Past"
+	TagPresent          Tag = "Present"
+	TagFuture           Tag = "Future"
+	TagPNG              Tag = "PNG" // Person-number-gender ending (விகுதி)
+	TagVerbalParticiple Tag = "VerbalParticiple"
+	TagInfinitive       Tag = "Infinitive"
+	TagProgressive      Tag = "Progressive" // Aspect auxiliary கொண்டு
+	TagAuxiliary        Tag = "Auxiliary"   // Aspect auxiliary இரு
+	TagCase             Tag = "Case"
+	TagPlural           Tag = "Plural"
+	TagClitic           Tag = "Clitic"
+)
+
+// Analysis state
+type State string
+
+// States of the default grammar
+const (
+	StateEnd      State = "End" // Word end; the analysis starts here
+	StateWord     State = "Word"
+	StateFinite   State = "Finite"   // Finite verb, less its PNG ending
+	StateVerbStem State = "VerbStem" // Verb stem, less its tense marker
+	StateAux      State = "Aux"      // Verb stem, less its auxiliary (இரு)
+	StateParticle State = "Particle" // Verbal participle (படித்து)
+	StateNounStem State = "NounStem" // Noun, less its case marker
+	StateVerb     State = "Verb"     // Verb root
+	StateNoun     State = "Noun"     // Noun root
+)
+
+// Transition of the suffix grammar
+type Transition struct {
+	From, To State
+	Suffix   string // Thamizh Unicode morpheme (stripped off the word end); empty for a transition stripping none
+	Tag      Tag
+}
+
+// Suffix grammar; the transitions (tried in order), and the root states by category
+type Grammar struct {
+	Transitions []Transition
+	Roots       map[State]Category
+}
+
+// Morpheme of an analysis
+type Morpheme struct {
+	Str script.String
+	Tag Tag
+}
+
+// Analysis of a word
+type Analysis struct {
+	Root      script.String
+	Category  Category
+	Morphemes []Morpheme // Suffix morphemes, in the word order
+}
+
+// Morphological analyzer of a suffix grammar and lexicon
+type Analyzer struct {
+	from    map[State][]transition // Transitions by their From state
+	roots   map[State]Category
+	lexicon Lexicon
+}
+
+// Compiled transition
+type transition struct {
+	to     State
+	suffix script.String
+	empty  bool // Strips none
+	tag    Tag
+}
+
+// Creates the analyzer of the suffix grammar and lexicon
+func NewAnalyzer(g Grammar, lexicon Lexicon) *Analyzer {
+	a := &Analyzer{from: map[State][]transition{}, roots: g.Roots, lexicon: lexicon}
+	for _, t := range g.Transitions {
+		ct := transition{to: t.To, empty: t.Suffix == "", tag: t.Tag}
+		if !ct.empty {
+			ct.suffix = script.MustDecode(t.Suffix)
+		}
+		a.from[t.From] = append(a.from[t.From], ct)
+	}
+	return a
+}
+
+// Analyses of the word, in the grammar order; none for an unknown word
+func (a *Analyzer) Analyze(word script.String) []Analysis {
+	var res []Analysis
+	a.walk(word, StateEnd, false, nil, map[State]bool{}, &res)
+	return res
+}
+
+// Walks the grammar from the state; vowelNext indicates the remainder is followed by a vowel-initial morpheme
+//
+// visited holds the states walked at the remainder (via empty transitions); guards against their cycles.
+func (a *Analyzer) walk(rem script.String, state State, vowelNext bool, morphs []Morpheme, visited map[State]bool, res *[]Analysis) {
+	if visited[state] {
+		return
+	}
+	visited[state] = true
+	if cat, ok := a.roots[state]; ok {
+		for _, root := range rootCandidates(rem, vowelNext) {
+			if !a.lexicon(root, cat) {
+				continue
+			}
+			an := Analysis{Root: root, Category: cat, Morphemes: slices.Clone(morphs)}
+			slices.Reverse(an.Morphemes)
+			if !slices.ContainsFunc(*res, an.equal) {
+				*res = append(*res, an)
+			}
+		}
+	}
+	for _, t := range a.from[state] {
+		if t.empty {
+			a.walk(rem, t.to, vowelNext, morphs, visited, res)
+			continue
+		}
+		forms := []script.String{t.suffix}
+		if vowelNext && t.suffix.EndsWithKutriyalukaram() {
+			elided, _ := t.suffix.TrimEnd(script.MustDecode("உ")) // கொண்டு + இரு => கொண்டிரு
+			forms = append(forms, elided)
+		}
+		for _, form := range forms {
+			if stem, ok := rem.TrimEnd(form); ok && stem.Len() > 0 {
+				a.walk(stem, t.to, t.suffix.FirstLetter().IsV(), append(slices.Clip(morphs), Morpheme{t.suffix, t.tag}), map[State]bool{}, res)
+			}
+		}
+	}
+}
+
+// Candidate roots of the remainder; itself and its repaired forms
+func rootCandidates(rem script.String, vowelNext bool) []script.String {
+	cands := []script.String{rem}
+	add := func(s script.String, ok bool) {
+		if ok && s.Len() > 0 && !slices.ContainsFunc(cands, func(c script.String) bool { return c.String() == s.String() }) {
+			cands = append(cands, s)
+		}
+	}
+	n := rem.Len()
+	last := rem.LastLetter()
+	if vowelNext && last.IsC() {
+		add(rem.Append(script.MustDecode("உ")), true) // பேச் => பேசு
+		if n > 1 && (last.IsLetter("ய்") || last.IsLetter("வ்")) && !rem.LetterAt(n-2).IsC() {
+			add(rem.TrimEnd(last.Str())) // உடம்படுமெய் (மணிய் => மணி)
+		}
+		if n == 3 && rem.LetterAt(n-2).Is(last) {
+			add(rem.TrimEnd(last.Str())) // Geminated after lone short letter (கண்ண் => கண்)
+		}
+	}
+	for _, r := range rootRepairs {
+		add(rem.ReplaceEnd(script.MustDecode(r[0]), script.MustDecode(r[1])))
+	}
+	if n > 2 {
+		// Doubled நெடில் தொடர் குற்றியலுகரம் (வீட்ட், வீட்டு => வீடு)
+		tail := last
+		if last.IsCV() {
+			tail, _ = last.SplitCV()
+		}
+		if double := rem.LetterAt(n - 2); double.IsC() && double.Is(tail) && rem.LetterAt(n-3).IsLongVocal() {
+			undoubled, _ := rem.TrimEnd(double.Str().Append(last.Str()))
+			add(undoubled.Append(tail.Str().Append(script.MustDecode("உ"))), true)
+		}
+	}
+	return cands
+}
+
+// Root-final replacements; oblique stems, and the assimilated root-final consonants
+var rootRepairs = [][2]string{
+	{"த்த்", "ம்"}, {"த்து", "ம்"}, // மரத்த், மரத்து => மரம்
+	{"ங்", "ம்"},               // மரங்கள் => மரம்
+	{"ட்", "ள்"}, {"ற்", "ல்"}, // கேட் => கேள்; கற் => கல்
+}
+
+func (a Analysis) equal(b Analysis) bool {
+	return a.Category == b.Category && a.Root.String() == b.Root.String() &&
+		slices.EqualFunc(a.Morphemes, b.Morphemes, func(m, n Morpheme) bool { return m.Tag == n.Tag && m.Str.String() == n.Str.String() })
+}
@@ -0,0 +1,87 @@
+package morph_test // Black box test
+
+import (
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+	"github.com/ThamizhLearner/Thamizh/morph"
+)
+
+var testLexicon = morph.NewLexicon(
+	[]string{"வீடு", "மரம்", "கண்", "மணி", "அடி", "அவன்", "பூ"},
+	[]string{"படி", "பேசு", "கேள்", "இரு", "அடி", "செய்", "உண்", "வளர்"},
+)
+
+// Analysis in the form: root/category +morpheme/tag ...
+func formatAnalysis(an morph.Analysis) string {
+	parts := []string{an.Root.String() + "/" + an.Category.String()}
+	for _, m := range an.Morphemes {
+		parts = append(parts, "+"+m.Str.String()+"/"+string(m.Tag))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		word     string
+		analyses []string
+	}{
+		{"படித்துக்கொண்டிருந்தாள்", []string{"படி/Verb +த்து/VerbalParticiple +க்கொண்டு/Progressive +இரு/Auxiliary +ந்த்/Past +ஆள்/PNG"}},
+		{"படித்திருந்தாள்", []string{"படி/Verb +த்து/VerbalParticiple +இரு/Auxiliary +ந்த்/Past +ஆள்/PNG"}},
+		{"பேசிக்கொண்டிருக்கிறார்கள்", []string{"பேசு/Verb +இ/VerbalParticiple +க்கொண்டு/Progressive +இரு/Auxiliary +க்கிற்/Present +ஆர்கள்/PNG"}},
+		{"பேசினான்", []string{"பேசு/Verb +இன்/Past +ஆன்/PNG"}},
+		{"கேட்டான்", []string{"கேள்/Verb +ட்/Past +ஆன்/PNG"}},
+		{"வளர்ந்தது", []string{"வளர்/Verb +ந்த்/Past +அது/PNG"}},
+		{"படிக்க", []string{"படி/Verb +க்க/Infinitive"}},
+		{"வீட்டில்", []string{"வீடு/Noun +இல்/Case"}},
+		{"மரங்களை", []string{"மரம்/Noun +கள்/Plural +ஐ/Case"}},
+		{"கண்ணை", []string{"கண்/Noun +ஐ/Case"}},
+		{"மணியை", []string{"மணி/Noun +ஐ/Case"}},
+		{"அவனுக்கும்", []string{"அவன்/Noun +உக்கு/Case +உம்/Clitic"}},
+		{"பூக்கள்", []string{"பூ/Noun +க்கள்/Plural"}},
+		{"அடி", []string{"அடி/Verb", "அடி/Noun"}}, // Ambiguous
+		{"கடல்", nil}, // Unknown
+	}
+	a := morph.NewAnalyzer(morph.DefaultGrammar, testLexicon)
+	for _, tc := range tests {
+		var got []string
+		for _, an := range a.Analyze(script.MustDecode(tc.word)) {
+			got = append(got, formatAnalysis(an))
+		}
+		if strings.Join(got, "; ") != strings.Join(tc.analyses, "; ") {
+			t.Errorf("Analyze %s, expected %v, got %v", tc.word, tc.analyses, got)
+		}
+	}
+}
+
+func TestCustomGrammar(t *testing.T) {
+	g := morph.Grammar{
+		Transitions: []morph.Transition{
+			{From: morph.StateEnd, To: morph.StateNoun, Suffix: "ஆக", Tag: "Adverbial"},
+		},
+		Roots: map[morph.State]morph.Category{morph.StateNoun: morph.NounRoot},
+	}
+	lexicon := func(root script.String, cat morph.Category) bool { return root.String() == "அழகு" }
+	analyses := morph.NewAnalyzer(g, lexicon).Analyze(script.MustDecode("அழகாக"))
+	if len(analyses) != 1 || formatAnalysis(analyses[0]) != "அழகு/Noun +ஆக/Adverbial" {
+		t.Errorf("Analyze அழகாக with custom grammar, expected அழகு/Noun +ஆக/Adverbial, got %v", analyses)
+	}
+}
+
+func TestEmptyTransitionCycle(t *testing.T) {
+	g := morph.Grammar{
+		Transitions: []morph.Transition{
+			{From: morph.StateEnd, To: morph.StateWord},
+			{From: morph.StateWord, To: morph.StateEnd}, // Cycle of empty transitions
+			{From: morph.StateWord, To: morph.StateNoun, Suffix: "ஆக", Tag: "Adverbial"},
+			{From: morph.StateNoun, To: morph.StateNoun},
+		},
+		Roots: map[morph.State]morph.Category{morph.StateNoun: morph.NounRoot},
+	}
+	lexicon := func(root script.String, cat morph.Category) bool { return root.String() == "அழகு" }
+	analyses := morph.NewAnalyzer(g, lexicon).Analyze(script.MustDecode("அழகாக"))
+	if len(analyses) != 1 || formatAnalysis(analyses[0]) != "அழகு/Noun +ஆக/Adverbial" {
+		t.Errorf("Analyze அழகாக with empty transition cycles, expected அழகு/Noun +ஆக/Adverbial, got %v", analyses)
+	}
+}
@@ -0,0 +1,271 @@
+// Verb conjugation (வினை); finite and non-finite forms of Thamizh verbs
+
+package morph
+
+import (
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+// Verb tense (காலம்)
+type Tense uint8
+
+const (
+	Past    Tense = iota // இறந்த காலம்
+	Present              // நிகழ் காலம்
+	Future               // எதிர் காலம்
+	tenseCount
+)
+
+// Stringer interface implementation
+func (t Tense) String() string {
+	switch t {
+	case Past:
+		return "Past"
+	case Present:
+		return "Present"
+	case Future:
+		return "Future"
+	default:
+		panic("Unexpected tense value")
+	}
+}
+
+// Person-number-gender (திணை, பால், எண், இடம்) of the finite verb; selects its ending (விகுதி)
+type PNG uint8
+
+const (
+	FirstSingular  PNG = iota // ஏன் (நான்)
+	SecondSingular            // ஆய் (நீ)
+	Masculine                 // ஆன் (அவன்)
+	Feminine                  // ஆள் (அவள்)
+	Honorific                 // ஆர் (அவர்)
+	Neuter                    // அது (அது); உம் in the future tense (படிக்கும்)
+	FirstPlural               // ஓம் (நாம்)
+	SecondPlural              // ஈர்கள் (நீங்கள்)
+	ThirdPlural               // ஆர்கள் (அவர்கள்)
+	pngCount
+)
+
+// Person-number-gender endings, by PNG
+var pngEndings = [pngCount]string{"ஏன்", "ஆய்", "ஆன்", "ஆள்", "ஆர்", "அது", "ஓம்", "ஈர்கள்", "ஆர்கள்"}
+
+// Stringer interface implementation
+func (p PNG) String() string {
+	switch p {
+	case FirstSingular:
+		return "FirstSingular"
+	case SecondSingular:
+		return "SecondSingular"
+	case Masculine:
+		return "Masculine"
+	case Feminine:
+		return "Feminine"
+	case Honorific:
+		return "Honorific"
+	case Neuter:
+		return "Neuter"
+	case FirstPlural:
+		return "FirstPlural"
+	case SecondPlural:
+		return "SecondPlural"
+	case ThirdPlural:
+		return "ThirdPlural"
+	default:
+		panic("Unexpected PNG value")
+	}
+}
+
+// Verb class; selects the tense markers (past / present / future)
+type VerbClass uint8
+
+const (
+	// த் / கிற் / வ் (செய் => செய்தான், செய்கிறான், செய்வான்)
+	WeakTha VerbClass = iota
+
+	// ந்த் / கிற் / வ் (வளர் => வளர்ந்தான், வளர்கிறான், வளர்வான்)
+	WeakNtha
+
+	// இன் / கிற் / வ் (பேசு => பேசினான், பேசுகிறான், பேசுவான்)
+	WeakIn
+
+	// ட் ற் / கிற் / ப்; roots ending in ள் ல் ண் ன் (கேள் => கேட்டான், கேட்கிறான், கேட்பான்; உண் => உண்டான்)
+	StrongTtRr
+
+	// த்த் / க்கிற் / ப்ப் (படி => படித்தான், படிக்கிறான், படிப்பான்)
+	StrongTtha
+
+	// ந்த் / க்கிற் / ப்ப் (இரு => இருந்தான், இருக்கிறான், இருப்பான்)
+	StrongNtha
+)
+
+// Stringer interface implementation
+func (c VerbClass) String() string {
+	switch c {
+	case WeakTha:
+		return "WeakTha (த்)"
+	case WeakNtha:
+		return "WeakNtha (ந்த்)"
+	case WeakIn:
+		return "WeakIn (இன்)"
+	case StrongTtRr:
+		return "StrongTtRr (ட் ற்)"
+	case StrongTtha:
+		return "StrongTtha (த்த்)"
+	case StrongNtha:
+		return "StrongNtha (ந்த்)"
+	default:
+		panic("Unexpected verb class value")
+	}
+}
+
+// Tense markers (இடைநிலைகள்), by verb class; StrongTtRr derives its markers off the root-final consonant
+var tenseMarkers = [...][tenseCount]string{
+	WeakTha:    {"த்", "கிற்", "வ்"},
+	WeakNtha:   {"ந்த்", "கிற்", "வ்"},
+	WeakIn:     {"இன்", "கிற்", "வ்"},
+	StrongTtha: {"த்த்", "க்கிற்", "ப்ப்"},
+	StrongNtha: {"ந்த்", "க்கிற்", "ப்ப்"},
+}
+
+// StrongTtRr root-final consonants, with their replacements in the past stem, and in the present/future stem
+var ttRrFinals = map[string][2]string{
+	"ள்": {"ட்ட்", "ட்"}, // கேள் => கேட்டான், கேட்கிறான்
+	"ல்": {"ற்ற்", "ற்"}, // கல் => கற்றான், கற்கிறான்
+	"ண்": {"ண்ட்", "ண்"}, // உண் => உண்டான், உண்கிறான்
+	"ன்": {"ன்ற்", "ன்"}, // தின் => தின்றான், தின்கிறான்
+}
+
+// Thamizh verb; root (the singular imperative) and its class
+//
+// Irregular verbs (வா, போ, சொல் ...) conjugate as per the given class only.
+type Verb struct {
+	Root  script.String
+	Class VerbClass
+}
+
+// Creates the verb of the (non-empty) root and class; false on a StrongTtRr root not ending in ள் ல் ண் ன்
+func NewVerb(root script.String, class VerbClass) (Verb, bool) {
+	if class == StrongTtRr {
+		if _, ok := ttRrFinals[root.LastLetter().String()]; !ok {
+			return Verb{}, false
+		}
+	}
+	return Verb{Root: root, Class: class}, true
+}
+
+// Guesses the verb class off the root's ending; a heuristic, the well-known exceptions (கொடு, விடு ...) aside
+//
+// ள் ல் ண் ன் => StrongTtRr; ய் => WeakTha; ர் ழ் => WeakNtha; இ ஈ ஐ => StrongTtha; அ and lone short
+// letter + உ (இரு) => StrongNtha; WeakIn otherwise (பேசு, ஓடு)
+func GuessClass(root script.String) VerbClass {
+	last := root.LastLetter()
+	v := finalVowel(root)
+	switch {
+	case last.IsC():
+		if _, ok := ttRrFinals[last.String()]; ok {
+			return StrongTtRr
+		}
+		if last.IsLetter("ர்") || last.IsLetter("ழ்") {
+			return WeakNtha
+		}
+		return WeakTha
+	case v.IsLetter("இ") || v.IsLetter("ஈ") || v.IsLetter("ஐ"):
+		return StrongTtha
+	case v.IsLetter("அ") || v.IsLetter("உ") && root.Len() == 2 && root.FirstLetter().IsShortVocal():
+		return StrongNtha
+	}
+	return WeakIn
+}
+
+// Tense stem; root followed by the tense marker (படித்த், படிக்கிற், படிப்ப்)
+func (v Verb) tenseStem(t Tense) script.String {
+	if v.Class == StrongTtRr {
+		last := v.Root.LastLetter()
+		final := ttRrFinals[last.String()]
+		stem, _ := v.Root.ReplaceEnd(last.Str(), script.MustDecode(final[min(int(t), 1)]))
+		switch t {
+		case Present:
+			return stem.Append(script.MustDecode("கிற்"))
+		case Future:
+			return stem.Append(script.MustDecode("ப்"))
+		}
+		return stem
+	}
+	return attach(v.Root, tenseMarkers[v.Class][t])
+}
+
+// Finite form (வினைமுற்று) of the tense and PNG (படித்தான், படிக்கிறோம்)
+func (v Verb) Finite(t Tense, p PNG) script.String {
+	if t == Future && p == Neuter {
+		inf := v.Infinitive() // படிக்க => படிக்கும்
+		form, _ := inf.ReplaceEnd(script.MustDecode("அ"), script.MustDecode("உம்"))
+		return form
+	}
+	if t == Past && p == Neuter && v.Class == WeakIn {
+		return attach(v.VerbalParticiple(), pngEndings[p]) // பேசியது
+	}
+	return attach(v.tenseStem(t), pngEndings[p])
+}
+
+// Infinitive (செயவெனெச்சம்); படிக்க, செய்ய, பேச
+func (v Verb) Infinitive() script.String {
+	switch v.Class {
+	case StrongTtha, StrongNtha:
+		return v.Root.Append(script.MustDecode("க்க"))
+	case StrongTtRr:
+		if last := v.Root.LastLetter(); last.IsLetter("ள்") || last.IsLetter("ல்") {
+			inf, _ := v.Root.ReplaceEnd(last.Str(), script.MustDecode(ttRrFinals[last.String()][1]+"க"))
+			return inf // கேட்க, கற்க
+		}
+	}
+	return attach(v.Root, "அ")
+}
+
+// Past verbal participle (வினையெச்சம்); படித்து, இருந்து, பேசி
+func (v Verb) VerbalParticiple() script.String {
+	if v.Class == WeakIn {
+		return attach(v.Root, "இ")
+	}
+	return v.tenseStem(Past).Append(script.MustDecode("உ"))
+}
+
+// Imperative (ஏவல்); the root (படி), or the polite/plural form (படியுங்கள்)
+func (v Verb) Imperative(polite bool) script.String {
+	if !polite {
+		return v.Root
+	}
+	return attach(v.Root, "உங்கள்")
+}
+
+// Finite form of a verb
+type FiniteForm struct {
+	Tense Tense
+	PNG   PNG
+	Form  script.String
+}
+
+// Finite forms of all the tenses and PNGs, in the tense and PNG order
+func (v Verb) FiniteForms() []FiniteForm {
+	forms := make([]FiniteForm, 0, int(tenseCount)*int(pngCount))
+	for t := range tenseCount {
+		for p := range pngCount {
+			forms = append(forms, FiniteForm{Tense: t, PNG: p, Form: v.Finite(t, p)})
+		}
+	}
+	return forms
+}
+
+// Attaches the suffix to the stem; a vowel-initial suffix replaces the stem-final உ (இரு + உங்கள் => இருங்கள்),
+// otherwise joins with the sandhi (செய் + அ => செய்ய; படி + உங்கள் => படியுங்கள்)
+func attach(stem script.String, suffix string) script.String {
+	s := script.MustDecode(suffix)
+	if !s.FirstLetter().IsV() {
+		return stem.Append(s)
+	}
+	if stem.LastLetter().IsCV() && finalVowel(stem).IsLetter("உ") {
+		stem, _ = stem.TrimEnd(script.MustDecode("உ"))
+		return stem.Append(s)
+	}
+	joined, _ := script.Join(stem, s, script.SandhiOptions{Enabled: script.SandhiGemination})
+	return joined
+}
@@ -0,0 +1,105 @@
+package morph_test // Black box test
+
+import (
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+	"github.com/ThamizhLearner/Thamizh/morph"
+)
+
+func TestConjugate(t *testing.T) {
+	tests := []struct {
+		root  string
+		class morph.VerbClass
+		forms [3]string // Finite forms, in the PNG order; past, present and future
+	}{
+		{"படி", morph.StrongTtha, [3]string{
+			"படித்தேன் படித்தாய் படித்தான் படித்தாள் படித்தார் படித்தது படித்தோம் படித்தீர்கள் படித்தார்கள்",
+			"படிக்கிறேன் படிக்கிறாய் படிக்கிறான் படிக்கிறாள் படிக்கிறார் படிக்கிறது படிக்கிறோம் படிக்கிறீர்கள் படிக்கிறார்கள்",
+			"படிப்பேன் படிப்பாய் படிப்பான் படிப்பாள் படிப்பார் படிக்கும் படிப்போம் படிப்பீர்கள் படிப்பார்கள்"}},
+		{"செய்", morph.WeakTha, [3]string{
+			"செய்தேன் செய்தாய் செய்தான் செய்தாள் செய்தார் செய்தது செய்தோம் செய்தீர்கள் செய்தார்கள்",
+			"செய்கிறேன் செய்கிறாய் செய்கிறான் செய்கிறாள் செய்கிறார் செய்கிறது செய்கிறோம் செய்கிறீர்கள் செய்கிறார்கள்",
+			"செய்வேன் செய்வாய் செய்வான் செய்வாள் செய்வார் செய்யும் செய்வோம் செய்வீர்கள் செய்வார்கள்"}},
+		{"பேசு", morph.WeakIn, [3]string{
+			"பேசினேன் பேசினாய் பேசினான் பேசினாள் பேசினார் பேசியது பேசினோம் பேசினீர்கள் பேசினார்கள்",
+			"பேசுகிறேன் பேசுகிறாய் பேசுகிறான் பேசுகிறாள் பேசுகிறார் பேசுகிறது பேசுகிறோம் பேசுகிறீர்கள் பேசுகிறார்கள்",
+			"பேசுவேன் பேசுவாய் பேசுவான் பேசுவாள் பேசுவார் பேசும் பேசுவோம் பேசுவீர்கள் பேசுவார்கள்"}},
+		{"கேள்", morph.StrongTtRr, [3]string{
+			"கேட்டேன் கேட்டாய் கேட்டான் கேட்டாள் கேட்டார் கேட்டது கேட்டோம் கேட்டீர்கள் கேட்டார்கள்",
+			"கேட்கிறேன் கேட்கிறாய் கேட்கிறான் கேட்கிறாள் கேட்கிறார் கேட்கிறது கேட்கிறோம் கேட்கிறீர்கள் கேட்கிறார்கள்",
+			"கேட்பேன் கேட்பாய் கேட்பான் கேட்பாள் கேட்பார் கேட்கும் கேட்போம் கேட்பீர்கள் கேட்பார்கள்"}},
+	}
+	for _, tc := range tests {
+		verb, ok := morph.NewVerb(script.MustDecode(tc.root), tc.class)
+		if !ok {
+			t.Errorf("NewVerb %s: Unexpected failure", tc.root)
+			continue
+		}
+		var got [3][]string
+		for _, f := range verb.FiniteForms() {
+			got[f.Tense] = append(got[f.Tense], f.Form.String())
+		}
+		for tense, forms := range tc.forms {
+			if joined := strings.Join(got[tense], " "); joined != forms {
+				t.Errorf("Conjugate %s (%v), expected %s, got %s", tc.root, morph.Tense(tense), forms, joined)
+			}
+		}
+	}
+}
+
+func TestNonFinite(t *testing.T) {
+	tests := []struct {
+		root                                   string
+		class                                  morph.VerbClass
+		masculinePast, inf, participle, polite string
+	}{
+		{"படி", morph.StrongTtha, "படித்தான்", "படிக்க", "படித்து", "படியுங்கள்"},
+		{"இரு", morph.StrongNtha, "இருந்தான்", "இருக்க", "இருந்து", "இருங்கள்"},
+		{"நட", morph.StrongNtha, "நடந்தான்", "நடக்க", "நடந்து", "நடவுங்கள்"},
+		{"வளர்", morph.WeakNtha, "வளர்ந்தான்", "வளர", "வளர்ந்து", "வளருங்கள்"},
+		{"செய்", morph.WeakTha, "செய்தான்", "செய்ய", "செய்து", "செய்யுங்கள்"},
+		{"பேசு", morph.WeakIn, "பேசினான்", "பேச", "பேசி", "பேசுங்கள்"},
+		{"ஓடு", morph.WeakIn, "ஓடினான்", "ஓட", "ஓடி", "ஓடுங்கள்"},
+		{"கேள்", morph.StrongTtRr, "கேட்டான்", "கேட்க", "கேட்டு", "கேளுங்கள்"},
+		{"கல்", morph.StrongTtRr, "கற்றான்", "கற்க", "கற்று", "கல்லுங்கள்"},
+		{"உண்", morph.StrongTtRr, "உண்டான்", "உண்ண", "உண்டு", "உண்ணுங்கள்"},
+		{"தின்", morph.StrongTtRr, "தின்றான்", "தின்ன", "தின்று", "தின்னுங்கள்"},
+	}
+	for _, tc := range tests {
+		verb, _ := morph.NewVerb(script.MustDecode(tc.root), tc.class)
+		got := []string{
+			verb.Finite(morph.Past, morph.Masculine).String(), verb.Infinitive().String(),
+			verb.VerbalParticiple().String(), verb.Imperative(true).String(),
+		}
+		expected := []string{tc.masculinePast, tc.inf, tc.participle, tc.polite}
+		if strings.Join(got, " ") != strings.Join(expected, " ") {
+			t.Errorf("Conjugate %s, expected %v, got %v", tc.root, expected, got)
+		}
+		if verb.Imperative(false).String() != tc.root {
+			t.Errorf("Imperative %s, got %s", tc.root, verb.Imperative(false))
+		}
+	}
+}
+
+func TestGuessClass(t *testing.T) {
+	tests := []struct {
+		root  string
+		class morph.VerbClass
+	}{
+		{"படி", morph.StrongTtha}, {"வை", morph.StrongTtha},
+		{"இரு", morph.StrongNtha}, {"நட", morph.StrongNtha},
+		{"செய்", morph.WeakTha}, {"வளர்", morph.WeakNtha}, {"வாழ்", morph.WeakNtha},
+		{"பேசு", morph.WeakIn}, {"ஓடு", morph.WeakIn},
+		{"கேள்", morph.StrongTtRr}, {"உண்", morph.StrongTtRr},
+	}
+	for _, tc := range tests {
+		if class := morph.GuessClass(script.MustDecode(tc.root)); class != tc.class {
+			t.Errorf("GuessClass %s, expected %v, got %v", tc.root, tc.class, class)
+		}
+	}
+	if _, ok := morph.NewVerb(script.MustDecode("படி"), morph.StrongTtRr); ok {
+		t.Errorf("NewVerb படி as StrongTtRr, expected failure")
+	}
+}
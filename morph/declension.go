@@ -0,0 +1,228 @@
+// Noun declension (வேற்றுமை); case forms of Thamizh nouns
+
+package morph
+
+import (
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+// Noun case (வேற்றுமை)
+type Case uint8
+
+// Noun cases, in the traditional order (முதல் - எட்டாம் வேற்றுமை)
+const (
+	Nominative   Case = iota // எழுவாய்; the noun itself
+	Accusative               // ஐ
+	Instrumental             // ஆல், ஒடு (ஓடு)
+	Dative                   // கு
+	Ablative                 // இன்
+	Genitive                 // அது
+	Locative                 // இல், கண்
+	Vocative                 // விளி
+)
+
+// Stringer interface implementation
+func (c Case) String() string {
+	switch c {
+	case Nominative:
+		return "Nominative"
+	case Accusative:
+		return "Accusative"
+	case Instrumental:
+		return "Instrumental"
+	case Dative:
+		return "Dative"
+	case Ablative:
+		return "Ablative"
+	case Genitive:
+		return "Genitive"
+	case Locative:
+		return "Locative"
+	case Vocative:
+		return "Vocative"
+	default:
+		panic("Unexpected case value")
+	}
+}
+
+// Oblique stem formation rule
+type StemRule uint8
+
+const (
+	// The noun itself is the oblique stem (அவன், மணி, கண்)
+	StemPlain StemRule = iota
+
+	// நெடில் தொடர் குற்றியலுகரம்: the final ட/ற doubles (வீடு => வீட்டு, ஆறு => ஆற்று)
+	StemDoubling
+
+	// அத்துச் சாரியை: the final ம் gives way to அத்து (மரம் => மரத்து)
+	StemAttu
+)
+
+// Stringer interface implementation
+func (r StemRule) String() string {
+	switch r {
+	case StemPlain:
+		return "Plain"
+	case StemDoubling:
+		return "Doubling (ஒற்று இரட்டுதல்)"
+	case StemAttu:
+		return "Attu (அத்துச் சாரியை)"
+	default:
+		panic("Unexpected stem rule value")
+	}
+}
+
+// Case form of a noun
+type CaseForm struct {
+	Case   Case
+	Marker string // Case marker (உருபு); empty for the nominative and vocative
+	Form   script.String
+}
+
+// Declension paradigm of a noun
+type Paradigm struct {
+	Noun     script.String
+	StemRule StemRule      // Oblique stem formation rule applied
+	Oblique  script.String // Oblique stem (வீட்டு, மரத்து); the noun itself for StemPlain
+	Plural   script.String // Plural stem (வீடுகள், மரங்கள்)
+
+	// Case forms in the case order; the instrumental (ஆல், ஓடு) and the locative (இல், கண்) have two forms each
+	SingularForms, PluralForms []CaseForm
+}
+
+// Case forms of the case; singular or plural
+func (p Paradigm) Forms(c Case, plural bool) []script.String {
+	forms := p.SingularForms
+	if plural {
+		forms = p.PluralForms
+	}
+	var res []script.String
+	for _, f := range forms {
+		if f.Case == c {
+			res = append(res, f.Form)
+		}
+	}
+	return res
+}
+
+// Case markers, in the case order
+var caseMarkers = []struct {
+	c      Case
+	marker string
+}{
+	{Nominative, ""},
+	{Accusative, "ஐ"},
+	{Instrumental, "ஆல்"},
+	{Instrumental, "ஓடு"},
+	{Dative, "கு"},
+	{Ablative, "இன்"},
+	{Genitive, "அது"},
+	{Locative, "இல்"},
+	{Locative, "கண்"},
+	{Vocative, ""},
+}
+
+// Declines the (non-empty) noun into its singular and plural case forms (வீடு => வீட்டை, வீடுகளை ...)
+func Decline(noun script.String) Paradigm {
+	p := Paradigm{Noun: noun, Oblique: noun, Plural: pluralStem(noun)}
+	switch {
+	case isDoublingStem(noun):
+		p.StemRule = StemDoubling
+		last := noun.LastLetter()
+		c, _ := last.SplitCV()
+		p.Oblique, _ = noun.ReplaceEnd(last.Str(), c.Str().Append(last.Str()))
+	case isAttuStem(noun):
+		p.StemRule = StemAttu
+		p.Oblique, _ = noun.ReplaceEnd(script.MustDecode("ம்"), script.MustDecode("த்து"))
+	}
+	p.SingularForms = declineForms(noun, p.Oblique, p.StemRule)
+	p.PluralForms = declineForms(p.Plural, p.Plural, StemPlain)
+	return p
+}
+
+// Case forms of the noun off its oblique stem
+func declineForms(noun, oblique script.String, rule StemRule) []CaseForm {
+	forms := make([]CaseForm, 0, len(caseMarkers))
+	for _, m := range caseMarkers {
+		form := CaseForm{Case: m.c, Marker: m.marker}
+		switch m.c {
+		case Nominative:
+			form.Form = noun
+		case Vocative:
+			form.Form = vocative(noun)
+		case Dative:
+			form.Form = dative(oblique)
+		case Genitive, Locative:
+			stem := oblique
+			if m.marker != "இல்" && (rule != StemPlain || !oblique.LastLetter().IsC() || m.marker == "கண்" && isShortClosed(oblique)) {
+				stem = join(oblique, "இன்") // இன் சாரியை (வீட்டினது, மணியின்கண், கண்ணின்கண்)
+			}
+			form.Form = join(stem, m.marker)
+		default:
+			form.Form = join(oblique, m.marker)
+		}
+		forms = append(forms, form)
+	}
+	return forms
+}
+
+// Joins the marker to the stem, with the sandhi (வீட்டு + ஐ => வீட்டை; மணி + ஐ => மணியை; கண் + ஐ => கண்ணை)
+func join(stem script.String, marker string) script.String {
+	joined, _ := script.Join(stem, script.MustDecode(marker), script.SandhiOptions{Enabled: script.SandhiGemination})
+	return joined
+}
+
+// Dative form; க்கு after உ, இ, ஈ and ஐ (வீட்டுக்கு, மணிக்கு), உக்கு otherwise (அவனுக்கு, அம்மாவுக்கு)
+func dative(stem script.String) script.String {
+	v := finalVowel(stem)
+	if stem.EndsWithKutriyalukaram() || v.IsLetter("இ") || v.IsLetter("ஈ") || v.IsLetter("ஐ") {
+		return stem.Append(script.MustDecode("க்கு"))
+	}
+	return join(stem, "உக்கு")
+}
+
+// Vocative form; ஏ joins the noun (அவனே, மரமே), ஆ-final nouns stand by themselves (அம்மா)
+func vocative(noun script.String) script.String {
+	if finalVowel(noun).IsLetter("ஆ") {
+		return noun
+	}
+	return join(noun, "ஏ")
+}
+
+// Plural stem; கள் joins the noun (மரம் => மரங்கள்), doubling க after a lone long letter but ஐ (பூ => பூக்கள்; கைகள்)
+func pluralStem(noun script.String) script.String {
+	if noun.Len() == 1 && noun.FirstLetter().IsLongVocal() && !finalVowel(noun).IsLetter("ஐ") {
+		return noun.Append(script.MustDecode("க்கள்"))
+	}
+	plural, _ := script.Join(noun, script.MustDecode("கள்"), script.SandhiOptions{Disabled: script.SandhiDoubling}) // கைகள்
+	return plural
+}
+
+// Indicates நெடில் தொடர் குற்றியலுகரம் on ட/ற (வீடு, ஆறு, நாடு)
+func isDoublingStem(noun script.String) bool {
+	if noun.Len() != 2 || !noun.FirstLetter().IsLongVocal() || !noun.EndsWithKutriyalukaram() {
+		return false
+	}
+	c, _ := noun.LastLetter().SplitCV()
+	return c.IsLetter("ட்") || c.IsLetter("ற்")
+}
+
+// Indicates a noun of a lone short letter followed by a consonant (கண், பல்); its final doubles before a vowel
+func isShortClosed(noun script.String) bool {
+	return noun.Len() == 2 && noun.FirstLetter().IsShortVocal() && noun.LastLetter().IsC()
+}
+
+// Indicates a ம்-final noun, but for the pronouns (நாம், தாம்) of a lone long letter
+func isAttuStem(noun script.String) bool {
+	return noun.Len() > 1 && noun.LastLetter().IsLetter("ம்") && !(noun.Len() == 2 && noun.FirstLetter().IsLongVocal())
+}
+
+// Final vowel (V, or the vowel of CV) of the string; the final letter itself otherwise
+func finalVowel(s script.String) script.Letter {
+	last := s.LastLetter()
+	if last.IsCV() {
+		_, last = last.SplitCV()
+	}
+	return last
+}
@@ -0,0 +1,92 @@
+package morph_test // Black box test
+
+import (
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+	"github.com/ThamizhLearner/Thamizh/morph"
+)
+
+func TestDecline(t *testing.T) {
+	tests := []struct {
+		noun    string
+		rule    morph.StemRule
+		oblique string
+		forms   string // Singular forms, in the case order
+		plural  string // Plural forms, in the case order
+	}{
+		{"வீடு", morph.StemDoubling, "வீட்டு",
+			"வீடு வீட்டை வீட்டால் வீட்டோடு வீட்டுக்கு வீட்டின் வீட்டினது வீட்டில் வீட்டின்கண் வீடே",
+			"வீடுகள் வீடுகளை வீடுகளால் வீடுகளோடு வீடுகளுக்கு வீடுகளின் வீடுகளது வீடுகளில் வீடுகட்கண் வீடுகளே"},
+		{"ஆறு", morph.StemDoubling, "ஆற்று",
+			"ஆறு ஆற்றை ஆற்றால் ஆற்றோடு ஆற்றுக்கு ஆற்றின் ஆற்றினது ஆற்றில் ஆற்றின்கண் ஆறே", ""},
+		{"மரம்", morph.StemAttu, "மரத்து",
+			"மரம் மரத்தை மரத்தால் மரத்தோடு மரத்துக்கு மரத்தின் மரத்தினது மரத்தில் மரத்தின்கண் மரமே",
+			"மரங்கள் மரங்களை மரங்களால் மரங்களோடு மரங்களுக்கு மரங்களின் மரங்களது மரங்களில் மரங்கட்கண் மரங்களே"},
+		{"அவன்", morph.StemPlain, "அவன்",
+			"அவன் அவனை அவனால் அவனோடு அவனுக்கு அவனின் அவனது அவனில் அவன்கண் அவனே", ""},
+		{"கண்", morph.StemPlain, "கண்",
+			"கண் கண்ணை கண்ணால் கண்ணோடு கண்ணுக்கு கண்ணின் கண்ணது கண்ணில் கண்ணின்கண் கண்ணே", ""},
+		{"பல்", morph.StemPlain, "பல்",
+			"பல் பல்லை பல்லால் பல்லோடு பல்லுக்கு பல்லின் பல்லது பல்லில் பல்லின்கண் பல்லே", ""},
+		{"மணி", morph.StemPlain, "மணி",
+			"மணி மணியை மணியால் மணியோடு மணிக்கு மணியின் மணியினது மணியில் மணியின்கண் மணியே", ""},
+		{"அம்மா", morph.StemPlain, "அம்மா",
+			"அம்மா அம்மாவை அம்மாவால் அம்மாவோடு அம்மாவுக்கு அம்மாவின் அம்மாவினது அம்மாவில் அம்மாவின்கண் அம்மா", ""},
+		{"கை", morph.StemPlain, "கை", "",
+			"கைகள் கைகளை கைகளால் கைகளோடு கைகளுக்கு கைகளின் கைகளது கைகளில் கைகட்கண் கைகளே"},
+		{"பூ", morph.StemPlain, "பூ", "",
+			"பூக்கள் பூக்களை பூக்களால் பூக்களோடு பூக்களுக்கு பூக்களின் பூக்களது பூக்களில் பூக்கட்கண் பூக்களே"},
+		{"நாம்", morph.StemPlain, "நாம்", "", ""},
+	}
+	joined := func(forms []morph.CaseForm) string {
+		var strs []string
+		for _, f := range forms {
+			strs = append(strs, f.Form.String())
+		}
+		return strings.Join(strs, " ")
+	}
+	for _, tc := range tests {
+		p := morph.Decline(script.MustDecode(tc.noun))
+		if p.StemRule != tc.rule || p.Oblique.String() != tc.oblique {
+			t.Errorf("Decline %s, expected %v %s, got %v %s", tc.noun, tc.rule, tc.oblique, p.StemRule, p.Oblique)
+		}
+		if got := joined(p.SingularForms); tc.forms != "" && got != tc.forms {
+			t.Errorf("Decline %s, expected %s, got %s", tc.noun, tc.forms, got)
+		}
+		if got := joined(p.PluralForms); tc.plural != "" && got != tc.plural {
+			t.Errorf("Decline %s (plural), expected %s, got %s", tc.noun, tc.plural, got)
+		}
+	}
+}
+
+func TestParadigmForms(t *testing.T) {
+	p := morph.Decline(script.MustDecode("வீடு"))
+	tests := []struct {
+		c      morph.Case
+		plural bool
+		forms  []string
+	}{
+		{morph.Accusative, false, []string{"வீட்டை"}},
+		{morph.Instrumental, false, []string{"வீட்டால்", "வீட்டோடு"}},
+		{morph.Locative, true, []string{"வீடுகளில்", "வீடுகட்கண்"}},
+	}
+	for _, tc := range tests {
+		forms := p.Forms(tc.c, tc.plural)
+		if len(forms) != len(tc.forms) {
+			t.Errorf("Forms %v, expected %v, got %v", tc.c, tc.forms, forms)
+			continue
+		}
+		for i, f := range forms {
+			if f.String() != tc.forms[i] {
+				t.Errorf("Forms %v, expected %s, got %s", tc.c, tc.forms[i], f)
+			}
+		}
+	}
+	for _, f := range p.SingularForms {
+		if f.Case == morph.Ablative && f.Marker != "இன்" {
+			t.Errorf("Ablative marker, expected இன், got %s", f.Marker)
+		}
+	}
+}
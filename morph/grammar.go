@@ -0,0 +1,45 @@
+// Default suffix grammar (data) of the morphological analyzer
+
+package morph
+
+// Case markers, as stripped by the analyzer
+var grammarCaseMarkers = []string{
+	"இலிருந்து", "உடைய", "உடன்", "உக்கு", "க்கு", "ஓடு", "ஆல்", "இல்", "இன்", "அது", "கண்", "ஐ",
+}
+
+// Verb tense markers, by tense
+var grammarTenseMarkers = [tenseCount][]string{
+	Past:    {"த்த்", "ந்த்", "த்", "ட்", "ற்", "இன்"},
+	Present: {"க்கிற்", "கிற்"},
+	Future:  {"ப்ப்", "ப்", "வ்"},
+}
+
+// Default suffix grammar
+//
+//	End -clitic-> Word -PNG-> Finite -tense-> VerbStem -இரு-> Aux -கொண்டு-> Particle -participle-> Verb
+//	Word -case-> NounStem -கள்-> Noun
+var DefaultGrammar = func() Grammar {
+	g := Grammar{Roots: map[State]Category{StateVerb: VerbRoot, StateNoun: NounRoot}}
+	add := func(from, to State, tag Tag, suffixes ...string) { // Empty suffix strips none
+		for _, s := range suffixes {
+			g.Transitions = append(g.Transitions, Transition{From: from, To: to, Suffix: s, Tag: tag})
+		}
+	}
+	add(StateEnd, StateWord, TagClitic, "", "உம்", "ஏ", "ஓ")
+
+	add(StateWord, StateFinite, TagPNG, pngEndings[:]...)
+	for t, markers := range grammarTenseMarkers {
+		add(StateFinite, StateVerbStem, []Tag{TagPast, TagPresent, TagFuture}[t], markers...)
+	}
+	add(StateVerbStem, StateVerb, "", "")
+	add(StateVerbStem, StateAux, TagAuxiliary, "இரு")            // படித்திருந்தாள்
+	add(StateAux, StateParticle, TagProgressive, "", "க்கொண்டு") // படித்துக்கொண்டிருந்தாள்
+	add(StateParticle, StateVerb, TagVerbalParticiple, "த்து", "ந்து", "து", "டு", "று", "இ")
+	add(StateWord, StateParticle, "", "")
+	add(StateWord, StateVerb, TagInfinitive, "", "க்க", "அ")
+
+	add(StateWord, StateNounStem, TagCase, "")
+	add(StateWord, StateNounStem, TagCase, grammarCaseMarkers...)
+	add(StateNounStem, StateNoun, TagPlural, "", "க்கள்", "கள்")
+	return g
+}()
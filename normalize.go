@@ -0,0 +1,32 @@
+// Thamizh Unicode normalization
+
+package script
+
+import "github.com/ThamizhLearner/Thamizh/internal/unicode"
+
+// Thamizh Unicode normalization form
+type NormForm uint8
+
+// Thamizh Unicode normalization forms
+const (
+	// Composed form; ொ ோ ௌ ஔ as single code points (as encoded by String)
+	NFC NormForm = iota
+
+	// Decomposed form; ொ ோ ௌ ஔ as two-part code point sequences (e.g. ெ + ா)
+	NFD
+)
+
+// Normalizes the two-part vowels and vowel signs of the given Unicode string into the given form
+//
+// Note: Only Thamizh code points are affected; rest of the string is retained as is.
+// Decode accepts both the forms, hence canonically-equivalent input decodes to the same String.
+func Normalize(ustr string, form NormForm) string {
+	switch form {
+	case NFC:
+		return unicode.Compose(ustr)
+	case NFD:
+		return unicode.Decompose(ustr)
+	default:
+		panic("Unexpected normalization form value")
+	}
+}
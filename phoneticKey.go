@@ -0,0 +1,102 @@
+// Thamizh phonetic key (Soundex-like), for fuzzy name matching
+
+package script
+
+import (
+	"strconv"
+	"strings"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+/*
+	Phonetic key algorithm, version 1:
+	0. Drop the prosthetic word-initial இ/உ before ர/ல-row letter (இராமன் => ராமன்)
+	1. Split each letter into its sounds; CV => C + V, ஸ்ரீ => ஸ் + ர் + ஈ
+	2. Map each sound onto its confusable class code (see the tables below)
+		- Vowel length is ignored (அ/ஆ => A); ஐ => AY (as அய்), ஔ => AV (as அவ்)
+		- ல/ள/ழ => L, ர/ற => R, ங/ஞ/ண/ந/ன => N, ட/த => T
+		- ச and the Grantha sibilants ஜ/ஶ/ஷ/ஸ => S, ஹ and ஃ => K (as க), க்ஷ => KS
+	3. Collapse the runs of the same class code (gemination; அய்யா => AYA)
+	4. Prefix the algorithm version number
+
+	The key of a given string never changes within an algorithm version; any change to the
+	above bumps PhoneticKeyVersion, so that persisted keys can be detected as stale.
+*/
+
+// Phonetic key algorithm version (leading the key)
+const PhoneticKeyVersion = 1
+
+// Confusable class codes of the 12 vowels (அ - ஔ)
+var phoneticVowels = [base.VowelCount]string{
+	"A", "A", "I", "I", "U", "U",
+	"E", "E", "AY", "O", "O", "AV",
+}
+
+// Confusable class codes of the consonant rows (க - ன, followed by ஜ ஶ ஷ ஸ ஹ க்ஷ)
+var phoneticConsonants = [base.ConsonantCount]string{
+	"K", "N", "S", "N", "T", "N",
+	"T", "N", "P", "M", "Y", "R",
+	"L", "V", "L", "L", "R", "N",
+	"S", "S", "S", "S", "K", "KS",
+}
+
+// Confusable class code of ஃ
+const phoneticAytham = "K"
+
+// Phonetic key of the string; equal for the confusable spellings (கணேசன், கனேசன்)
+//
+// Key format: the version number followed by the class codes (e.g. 1KANESAN)
+func (s String) PhoneticKey() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(PhoneticKeyVersion))
+	last := byte(0)
+	write := func(code string) {
+		for i := range len(code) {
+			if code[i] != last {
+				sb.WriteByte(code[i])
+				last = code[i]
+			}
+		}
+	}
+	start := 0
+	if isProstheticVowel(s) {
+		start = 1
+	}
+	for _, idx := range s.idxs[start:] {
+		writePhoneticCodes(Letter{idx: idx}, write)
+	}
+	return sb.String()
+}
+
+// Indicates the string starts with prosthetic இ/உ (before ர/ல-row letter)
+func isProstheticVowel(s String) bool {
+	if s.Len() < 2 || !s.FirstLetter().IsLetter("இ") && !s.FirstLetter().IsLetter("உ") {
+		return false
+	}
+	next := s.LetterAt(1)
+	if !next.IsCV() {
+		return false
+	}
+	c, _ := next.SplitCV()
+	return c.IsLetter("ர்") || c.IsLetter("ல்")
+}
+
+func writePhoneticCodes(letter Letter, write func(string)) {
+	switch {
+	case letter.IsV():
+		write(phoneticVowels[letter.idx])
+	case letter.IsC():
+		write(phoneticConsonants[base.CRow(letter.idx)])
+	case letter.IsCV():
+		c, v := letter.SplitCV()
+		writePhoneticCodes(c, write)
+		writePhoneticCodes(v, write)
+	case letter.IsAytham():
+		write(phoneticAytham)
+	default: // ஸ்ரீ
+		lead, rest, _ := base.SplitConjunct(letter.idx)
+		writePhoneticCodes(Letter{idx: lead}, write)
+		writePhoneticCodes(Letter{idx: rest}, write)
+	}
+}
@@ -0,0 +1,50 @@
+package script_test // Black box test
+
+import (
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestPhoneticKey(t *testing.T) {
+	tests := []struct {
+		ustr string
+		key  string
+	}{
+		{"கணேசன்", "1KANESAN"},
+		{"தமிழ்", "1TAMIL"},
+		{"ஐயா", "1AYA"},
+		{"ஔவை", "1AVAY"},
+		{"லக்ஷ்மி", "1LAKSMI"},
+		{"ஸ்ரீ", "1SRI"},
+		{"எஃகு", "1EKU"},
+	}
+	for _, tc := range tests {
+		if got := script.MustDecode(tc.ustr).PhoneticKey(); got != tc.key {
+			t.Errorf("PhoneticKey %s, expected %s, got %s", tc.ustr, tc.key, got)
+		}
+	}
+
+	matches := [][]string{
+		{"கணேசன்", "கனேசன்", "கணேஷன்"},
+		{"வள்ளி", "வல்லி", "வழ்ழி"},
+		{"முருகன்", "முறுகன்", "முருகண்"},
+		{"ராஜா", "ராசா", "இராசா"},
+		{"ஐயா", "அய்யா"},
+		{"லட்சுமி", "இலட்சுமி"},
+		{"ஔவை", "அவ்வை"},
+		{"காமாட்சி", "கமாட்சி", "காமாட்ஷி"},
+	}
+	for _, names := range matches {
+		key := script.MustDecode(names[0]).PhoneticKey()
+		for _, name := range names[1:] {
+			if got := script.MustDecode(name).PhoneticKey(); got != key {
+				t.Errorf("PhoneticKey %s, expected %s (as %s), got %s", name, key, names[0], got)
+			}
+		}
+	}
+
+	if script.MustDecode("கணேசன்").PhoneticKey() == script.MustDecode("கமலா").PhoneticKey() {
+		t.Errorf("PhoneticKey expected to differ for distinct names")
+	}
+}
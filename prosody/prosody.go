@@ -0,0 +1,188 @@
+// Thamizh prosody (யாப்பிலக்கணம்); அசை, சீர் and line patterns
+
+package prosody
+
+import (
+	"strings"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+/*
+	அசை division (அலகிடுதல்), over the word syllables (V/CV letter followed by its ஒற்றுகள்):
+	1. நேர்: a lone குறில் or நெடில், with or without ஒற்று (க, கல், கா, கால்)
+	2. நிரை: குறில் (without ஒற்று) followed by a குறில் or நெடில், with or without ஒற்று (கட, கடல், கடா, கடால்)
+	3. ஐகாரக் குறுக்கம்: ஐ past the word start counts as குறில் (வேலையில்லை => வே-லையில்-லை)
+	4. குற்றியலுகரம்: counts as குறில்; word-final, it elides before a word-initial vowel (நாடு அழகு => நாட்-அழகு),
+	   also across the lines of a stanza
+*/
+
+// அசை (metrical syllable)
+type Asai uint8
+
+const (
+	Ner   Asai = iota // நேர்
+	Nirai             // நிரை
+)
+
+// Stringer interface implementation
+func (a Asai) String() string {
+	switch a {
+	case Ner:
+		return "நேர்"
+	case Nirai:
+		return "நிரை"
+	default:
+		panic("Unexpected asai value")
+	}
+}
+
+// அசை of a word
+type AsaiUnit struct {
+	Asai Asai
+	Str  script.String
+
+	// Lone word-final குற்றியலுகரம் (காசு, பிறப்பு); counts as நேர், while the ஈற்றுச்சீர் may read it as நேர்பு/நிரைபு
+	Kutriyalukaram bool
+}
+
+// Word syllable, classified for the அசை division
+type syllable struct {
+	str   script.String
+	short bool // குறில் (also shortened ஐ and குற்றியலுகரம்)
+	ottru bool // Followed by ஒற்று (consonant or aytham)
+}
+
+// Syllables of the word, classified for the அசை division
+func syllables(word script.String) []syllable {
+	var syls []syllable
+	for i, str := range word.Syllables() {
+		first := str.FirstLetter()
+		short := first.IsShortVocal()
+		if i > 0 && hasVowel(first, "ஐ") {
+			short = true // ஐகாரக் குறுக்கம்
+		}
+		syls = append(syls, syllable{str: str, short: short, ottru: str.Len() > 1})
+	}
+	return syls
+}
+
+// Indicates the V/CV letter sounds the vowel (ஐ => ஐ, கை, மை)
+func hasVowel(chr script.Letter, vowel string) bool {
+	if chr.IsCV() {
+		_, chr = chr.SplitCV()
+	}
+	return chr.IsLetter(vowel)
+}
+
+// Divides the (non-empty) word into its அசைகள்
+func Asais(word script.String) []AsaiUnit {
+	syls := syllables(word)
+	var asais []AsaiUnit
+	for i := 0; i < len(syls); i++ {
+		syl := syls[i]
+		if syl.short && !syl.ottru && i+1 < len(syls) {
+			asais = append(asais, AsaiUnit{Asai: Nirai, Str: syl.str.Append(syls[i+1].str)})
+			i++
+			continue
+		}
+		asais = append(asais, AsaiUnit{Asai: Ner, Str: syl.str})
+	}
+	if last := &asais[len(asais)-1]; len(asais) > 1 && last.Asai == Ner && last.Str.Len() == 1 && word.EndsWithKutriyalukaram() {
+		last.Kutriyalukaram = true
+	}
+	return asais
+}
+
+// சீர் (metrical foot); a word of the line
+type Seer struct {
+	Word   script.String // Word, as written
+	Elided bool          // Final குற்றியலுகரம் elided before the next word's initial vowel
+	Asais  []AsaiUnit
+}
+
+// Scans the (non-empty) word into its சீர்
+func Scan(word script.String) Seer { return Seer{Word: word, Asais: Asais(word)} }
+
+// Scans the word, eliding its final குற்றியலுகரம் (நாடு => நாட்)
+func scanElided(word script.String) Seer {
+	elided, _ := word.TrimEnd(script.MustDecode("உ"))
+	return Seer{Word: word, Elided: true, Asais: Asais(elided)}
+}
+
+// அசை pattern of the சீர் (நேர்-நிரை)
+func (s Seer) Pattern() string {
+	strs := make([]string, len(s.Asais))
+	for i, a := range s.Asais {
+		strs[i] = a.Asai.String()
+	}
+	return strings.Join(strs, "-")
+}
+
+// Names (வாய்பாடு) of the ஓரசை and ஈரசைச் சீர்கள், by their அசைகள் (0: நேர், 1: நிரை)
+var (
+	oneAsaiNames = [2]string{"நாள்", "மலர்"}
+	twoAsaiNames = [2][2]string{{"தேமா", "கூவிளம்"}, {"புளிமா", "கருவிளம்"}}
+)
+
+// Name stems of the மூவசை and நாலசைச் சீர்கள், by their first two அசைகள்
+var (
+	threeAsaiStems = [2][2]string{{"தேமாங்", "கூவிளங்"}, {"புளிமாங்", "கருவிளங்"}}
+	fourAsaiStems  = [2][2]string{{"தேமா", "கூவிள"}, {"புளிமா", "கருவிள"}}
+)
+
+// Name endings of the மூவசைச் சீர்கள் (காய், கனி), and of the நாலசைச் சீர்கள், by their last two அசைகள்
+var (
+	threeAsaiEnds = [2]string{"காய்", "கனி"}
+	fourAsaiEnds  = [2][2]string{{"ந்தண்பூ", "ந்தண்ணிழல்"}, {"நறும்பூ", "நறுநிழல்"}}
+)
+
+// வாய்பாடு of the சீர் (தேமா, புளிமா, கூவிளங்காய் ...); empty past four அசைகள்
+func (s Seer) Vaaypaadu() string {
+	a := s.Asais
+	switch len(a) {
+	case 1:
+		return oneAsaiNames[a[0].Asai]
+	case 2:
+		return twoAsaiNames[a[0].Asai][a[1].Asai]
+	case 3:
+		return threeAsaiStems[a[0].Asai][a[1].Asai] + threeAsaiEnds[a[2].Asai]
+	case 4:
+		return fourAsaiStems[a[0].Asai][a[1].Asai] + fourAsaiEnds[a[2].Asai][a[3].Asai]
+	}
+	return ""
+}
+
+// Line (அடி) of சீர்கள்
+type Line struct {
+	Seers []Seer
+}
+
+// Scans the (non-empty) words of the line into their சீர்கள்
+//
+// A word-final குற்றியலுகரம் elides before the next word's initial vowel.
+func ScanLine(words []script.String) Line {
+	var line Line
+	for i, word := range words {
+		if i+1 < len(words) && elides(word, words[i+1]) {
+			line.Seers = append(line.Seers, scanElided(word))
+			continue
+		}
+		line.Seers = append(line.Seers, Scan(word))
+	}
+	return line
+}
+
+// Indicates the word's final குற்றியலுகரம் elides before the next word
+func elides(word, next script.String) bool {
+	return word.EndsWithKutriyalukaram() && next.FirstLetter().IsV()
+}
+
+// வாய்பாடு pattern of the line (புளிமா புளிமா புளிமாங்காய் தேமா)
+func (l Line) Pattern() string {
+	strs := make([]string, len(l.Seers))
+	for i, s := range l.Seers {
+		strs[i] = s.Vaaypaadu()
+	}
+	return strings.Join(strs, " ")
+}
@@ -0,0 +1,94 @@
+package prosody_test // Black box test
+
+import (
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+	"github.com/ThamizhLearner/Thamizh/prosody"
+)
+
+func TestAsais(t *testing.T) {
+	tests := []struct {
+		word  string
+		asais string // அசைகள், separated by "-"
+		want  string // Pattern
+	}{
+		{"க", "க", "நேர்"},
+		{"கல்", "கல்", "நேர்"},
+		{"கடல்", "கடல்", "நிரை"},
+		{"கடா", "கடா", "நிரை"},
+		{"ஆதி", "ஆ-தி", "நேர்-நேர்"},
+		{"அகர", "அக-ர", "நிரை-நேர்"},
+		{"எழுத்தெல்லாம்", "எழுத்-தெல்-லாம்", "நிரை-நேர்-நேர்"},
+		{"வேலையில்லை", "வே-லையில்-லை", "நேர்-நிரை-நேர்"}, // ஐகாரக் குறுக்கம்
+		{"ஐயம்", "ஐ-யம்", "நேர்-நேர்"},                   // Word-initial ஐ
+		{"உலகு", "உல-கு", "நிரை-நேர்"},
+	}
+	for _, tc := range tests {
+		seer := prosody.Scan(script.MustDecode(tc.word))
+		var strs []string
+		for _, a := range seer.Asais {
+			strs = append(strs, a.Str.String())
+		}
+		if got := strings.Join(strs, "-"); got != tc.asais || seer.Pattern() != tc.want {
+			t.Errorf("Asais %s, expected %s (%s), got %s (%s)", tc.word, tc.asais, tc.want, got, seer.Pattern())
+		}
+	}
+}
+
+func TestKutriyalukaram(t *testing.T) {
+	tests := []struct {
+		word  string
+		kutri bool
+	}{
+		{"உலகு", true}, {"காசு", true}, {"பிறப்பு", true},
+		{"பசு", false}, // Short letter + உ (முற்றியலுகரம்)
+		{"கதவு", false},
+	}
+	for _, tc := range tests {
+		asais := prosody.Asais(script.MustDecode(tc.word))
+		if got := asais[len(asais)-1].Kutriyalukaram; got != tc.kutri {
+			t.Errorf("Kutriyalukaram %s, expected %v, got %v", tc.word, tc.kutri, got)
+		}
+	}
+}
+
+func TestVaaypaadu(t *testing.T) {
+	tests := []struct {
+		word, vaaypaadu string
+	}{
+		{"நாள்", "நாள்"}, {"மலர்", "மலர்"},
+		{"ஆதி", "தேமா"}, {"பகவன்", "புளிமா"}, {"கற்றதனால்", "கூவிளங்காய்"}, {"வாலறிவன்", "கூவிளங்காய்"},
+		{"அறவாழி", "புளிமாங்காய்"}, {"தொழாஅர்", "புளிமா"}, {"மலர்மிசை", "கருவிளம்"},
+		{"பிறவிப்பெருங்கடல்", "புளிமாநறுநிழல்"},
+		{"தேமாந்தண்பூ", "தேமாந்தண்பூ"},
+		{"கருவிளங்கனி", "கருவிளங்கனி"},
+	}
+	for _, tc := range tests {
+		if got := prosody.Scan(script.MustDecode(tc.word)).Vaaypaadu(); got != tc.vaaypaadu {
+			t.Errorf("Vaaypaadu %s, expected %s, got %s", tc.word, tc.vaaypaadu, got)
+		}
+	}
+}
+
+func TestScanLine(t *testing.T) {
+	tests := []struct {
+		line, pattern string
+	}{
+		{"அகர முதல எழுத்தெல்லாம் ஆதி", "புளிமா புளிமா புளிமாங்காய் தேமா"},
+		{"பகவன் முதற்றே உலகு", "புளிமா புளிமா புளிமா"},
+		{"நாடு அழகு", "நாள் புளிமா"}, // குற்றியலுகரம் elides before a vowel
+		{"நாடு பெரிது", "தேமா புளிமா"},
+	}
+	for _, tc := range tests {
+		var words []script.String
+		for _, w := range strings.Fields(tc.line) {
+			words = append(words, script.MustDecode(w))
+		}
+		line := prosody.ScanLine(words)
+		if got := line.Pattern(); got != tc.pattern {
+			t.Errorf("ScanLine %s, expected %s, got %s", tc.line, tc.pattern, got)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+// Verse (பா) validation; தளை, line and ஈற்றுச்சீர் checks of the stanza
+
+package prosody
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+/*
+	Simplified rules checked per meter (பா):
+	1. வெண்பா: வெண்டளை at every junction (also across the lines); இயற்சீர் and காய்ச்சீர் only;
+	   two lines or more, four சீர்கள் each but the last line's three; ஈற்றுச்சீர் நாள், மலர், காசு or பிறப்பு
+	   (the final உ of காசு and பிறப்பு is குற்றியலுகரம், or முற்றியலுகரம்; அறிவு => பிறப்பு)
+	2. ஆசிரியப்பா: ஆசிரியத்தளை at half the junctions or more; no கனிச்சீர்; three lines or more,
+	   four சீர்கள் each but the penultimate line's (three, நேரிசை); ends in ஏ
+	3. கலிப்பா: கலித்தளை at half the junctions or more; காய்ச்சீர் at half the சீர்கள் or more, and கனிச்சீர் fewer;
+	   four lines or more, four சீர்கள் each
+*/
+
+// Verse meter (பா)
+type Meter uint8
+
+const (
+	Venba       Meter = iota // வெண்பா
+	Aasiriyappa              // ஆசிரியப்பா
+	Kalippa                  // கலிப்பா
+)
+
+// Stringer interface implementation
+func (m Meter) String() string {
+	switch m {
+	case Venba:
+		return "வெண்பா"
+	case Aasiriyappa:
+		return "ஆசிரியப்பா"
+	case Kalippa:
+		return "கலிப்பா"
+	default:
+		panic("Unexpected meter value")
+	}
+}
+
+// தளை (linkage between adjacent சீர்கள்)
+type Thalai uint8
+
+const (
+	NerOndriyaAasiriyam   Thalai = iota // நேரொன்றிய ஆசிரியத்தளை; மா முன் நேர்
+	NiraiOndriyaAasiriyam               // நிரையொன்றிய ஆசிரியத்தளை; விளம் முன் நிரை
+	IyarseerVendalai                    // இயற்சீர் வெண்டளை; மா முன் நிரை, விளம் முன் நேர்
+	VenseerVendalai                     // வெண்சீர் வெண்டளை; காய் முன் நேர்
+	Kalithalai                          // கலித்தளை; காய் முன் நிரை
+	OndriyaVanjithalai                  // ஒன்றிய வஞ்சித்தளை; கனி முன் நிரை
+	OndraathaVanjithalai                // ஒன்றாத வஞ்சித்தளை; கனி முன் நேர்
+)
+
+// Stringer interface implementation
+func (t Thalai) String() string {
+	switch t {
+	case NerOndriyaAasiriyam:
+		return "நேரொன்றிய ஆசிரியத்தளை"
+	case NiraiOndriyaAasiriyam:
+		return "நிரையொன்றிய ஆசிரியத்தளை"
+	case IyarseerVendalai:
+		return "இயற்சீர் வெண்டளை"
+	case VenseerVendalai:
+		return "வெண்சீர் வெண்டளை"
+	case Kalithalai:
+		return "கலித்தளை"
+	case OndriyaVanjithalai:
+		return "ஒன்றிய வஞ்சித்தளை"
+	case OndraathaVanjithalai:
+		return "ஒன்றாத வஞ்சித்தளை"
+	default:
+		panic("Unexpected thalai value")
+	}
+}
+
+// Indicates வெண்டளை
+func (t Thalai) IsVendalai() bool { return t == IyarseerVendalai || t == VenseerVendalai }
+
+// Indicates ஆசிரியத்தளை
+func (t Thalai) IsAasiriyaththalai() bool {
+	return t == NerOndriyaAasiriyam || t == NiraiOndriyaAasiriyam
+}
+
+// தளை between the சீர் and the following சீர்; ஓரசைச் சீர்கள் link as இயற்சீர், நாலசைச் சீர்கள் as மூவசைச் சீர்கள்
+func LinkThalai(s, next Seer) Thalai {
+	last, first := s.Asais[len(s.Asais)-1].Asai, next.Asais[0].Asai
+	switch {
+	case len(s.Asais) <= 2 && last == first:
+		return []Thalai{Ner: NerOndriyaAasiriyam, Nirai: NiraiOndriyaAasiriyam}[last]
+	case len(s.Asais) <= 2:
+		return IyarseerVendalai
+	case last == Ner && first == Ner:
+		return VenseerVendalai
+	case last == Ner:
+		return Kalithalai
+	case first == Nirai:
+		return OndriyaVanjithalai
+	default:
+		return OndraathaVanjithalai
+	}
+}
+
+// ஈற்றுச்சீர் வாய்பாடு of the வெண்பா's final சீர் (நாள், மலர், காசு, பிறப்பு); empty for none of those
+func (s Seer) FinalVaaypaadu() string {
+	switch a := s.Asais; {
+	case len(a) == 1:
+		return oneAsaiNames[a[0].Asai]
+	case len(a) == 2 && (a[1].Kutriyalukaram || isMutriyalukaram(a[1])):
+		return []string{Ner: "காசு", Nirai: "பிறப்பு"}[a[0].Asai]
+	}
+	return ""
+}
+
+// Indicates lone word-final முற்றியலுகரம் (அறிவு, மாவு); reads as the ஈற்றுச்சீர்'s உ, as குற்றியலுகரம் does
+func isMutriyalukaram(a AsaiUnit) bool {
+	return a.Asai == Ner && a.Str.Len() == 1 && a.Str.FirstLetter().IsCV() && hasVowel(a.Str.FirstLetter(), "உ")
+}
+
+// Indicates கனிச்சீர் (மூவசைச் சீர் ending in நிரை)
+func (s Seer) isKani() bool { return len(s.Asais) == 3 && s.Asais[2].Asai == Nirai }
+
+// Indicates காய்ச்சீர் (மூவசைச் சீர் ending in நேர்)
+func (s Seer) isKaay() bool { return len(s.Asais) == 3 && s.Asais[2].Asai == Ner }
+
+// Junction of adjacent சீர்கள்; the சீர் at Line/Seer, and the following one (possibly of the next line)
+type Junction struct {
+	Line, Seer int
+	Thalai     Thalai
+}
+
+// Verse rule violation kind
+type ViolationKind uint8
+
+const (
+	ViolationWord      ViolationKind = iota // Word not decoding as Thamizh
+	ViolationLineCount                      // Too few lines
+	ViolationSeerCount                      // Line of the wrong சீர் count
+	ViolationSeer                           // சீர் not allowed by the meter
+	ViolationThalai                         // தளை not allowed (or not predominant) by the meter
+	ViolationFinalSeer                      // ஈற்றுச்சீர் not allowed by the meter
+	ViolationEnding                         // Stanza ending not allowed by the meter
+)
+
+// Stringer interface implementation
+func (k ViolationKind) String() string {
+	switch k {
+	case ViolationWord:
+		return "Word"
+	case ViolationLineCount:
+		return "LineCount"
+	case ViolationSeerCount:
+		return "SeerCount"
+	case ViolationSeer:
+		return "Seer"
+	case ViolationThalai:
+		return "Thalai"
+	case ViolationFinalSeer:
+		return "FinalSeer"
+	case ViolationEnding:
+		return "Ending"
+	default:
+		panic("Unexpected violation kind value")
+	}
+}
+
+// Verse rule violation; positions (0-based) are -1 when not applicable (the stanza or whole line)
+type Violation struct {
+	Kind       ViolationKind
+	Line, Seer int
+	Detail     string
+}
+
+// Validated stanza
+type Verse struct {
+	Meter      Meter
+	Lines      []Line     // Of the non-blank input lines; no சீர்கள் for a line of no Thamizh words
+	Junctions  []Junction // In the stanza order
+	Violations []Violation
+}
+
+// Indicates the stanza conforms to its meter
+func (v Verse) Valid() bool { return len(v.Violations) == 0 }
+
+// Validates the stanza (multi-line text; blank lines ignored) as per the meter
+//
+// Words are separated by white space and punctuation; words not decoding as Thamizh are reported and skipped.
+// Each word scans as one சீர்; the stanza is to be given in its சீர் division (வேண்டுதல்வேண் டாமை), as the
+// validator does not re-divide the words where the word division fails a junction.
+func Validate(text string, meter Meter) Verse {
+	v := Verse{Meter: meter}
+	for _, ustr := range strings.Split(text, "\n") {
+		uwords := strings.FieldsFunc(ustr, isWordSeparator)
+		if len(uwords) == 0 {
+			continue
+		}
+		var words []script.String
+		for _, uword := range uwords {
+			word, ok := script.Decode(uword)
+			if !ok {
+				v.violate(ViolationWord, len(v.Lines), len(words), "Not Thamizh: %q", uword)
+				continue
+			}
+			words = append(words, word)
+		}
+		v.Lines = append(v.Lines, ScanLine(words)) // Even with no words, keeping the line positions
+	}
+	for i := 1; i < len(v.Lines); i++ {
+		// Line-final குற்றியலுகரம் elides before the next line's initial vowel too
+		seers, next := v.Lines[i-1].Seers, v.Lines[i].Seers
+		if len(seers) == 0 || len(next) == 0 {
+			continue
+		}
+		if last := &seers[len(seers)-1]; elides(last.Word, next[0].Word) {
+			*last = scanElided(last.Word)
+		}
+	}
+	v.link()
+	switch meter {
+	case Venba:
+		v.checkVenba()
+	case Aasiriyappa:
+		v.checkAasiriyappa()
+	case Kalippa:
+		v.checkKalippa()
+	}
+	return v
+}
+
+// Punctuation (and white space) separating the words; Thamizh vowel signs and pulli are no separators
+func isWordSeparator(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsDigit(r)
+}
+
+func (v *Verse) violate(kind ViolationKind, line, seer int, format string, args ...any) {
+	v.Violations = append(v.Violations, Violation{Kind: kind, Line: line, Seer: seer, Detail: fmt.Sprintf(format, args...)})
+}
+
+// Links the adjacent சீர்கள், also across the lines (skipping those of no சீர்கள்)
+func (v *Verse) link() {
+	for i, line := range v.Lines {
+		for j, seer := range line.Seers {
+			var next *Seer
+			if j+1 < len(line.Seers) {
+				next = &line.Seers[j+1]
+			}
+			for k := i + 1; next == nil && k < len(v.Lines); k++ {
+				if len(v.Lines[k].Seers) > 0 {
+					next = &v.Lines[k].Seers[0]
+				}
+			}
+			if next != nil {
+				v.Junctions = append(v.Junctions, Junction{Line: i, Seer: j, Thalai: LinkThalai(seer, *next)})
+			}
+		}
+	}
+}
+
+// Checks the line count (minimum), and the சீர் count of each line; penultimate/last line's alternate count, if any
+func (v *Verse) checkLines(minLines, seers, penultimate, last int) {
+	n := len(v.Lines)
+	if n < minLines {
+		v.violate(ViolationLineCount, -1, -1, "%d lines; %v needs %d or more", n, v.Meter, minLines)
+	}
+	for i, line := range v.Lines {
+		want := seers
+		switch {
+		case i == n-1 && last > 0:
+			want = last
+		case i == n-2 && penultimate > 0 && len(line.Seers) == penultimate:
+			want = penultimate
+		}
+		if len(line.Seers) != want {
+			v.violate(ViolationSeerCount, i, -1, "%d சீர்கள்; expected %d", len(line.Seers), want)
+		}
+	}
+}
+
+// Checks the தளை at every junction is one of the allowed
+func (v *Verse) checkEachThalai(allowed func(Thalai) bool) {
+	for _, j := range v.Junctions {
+		if !allowed(j.Thalai) {
+			v.violate(ViolationThalai, j.Line, j.Seer, "%v not allowed in %v", j.Thalai, v.Meter)
+		}
+	}
+}
+
+// Checks the தளை kind is at half the junctions or more
+func (v *Verse) checkPredominantThalai(kind func(Thalai) bool, name string) {
+	count := 0
+	for _, j := range v.Junctions {
+		if kind(j.Thalai) {
+			count++
+		}
+	}
+	if 2*count < len(v.Junctions) {
+		v.violate(ViolationThalai, -1, -1, "%s at %d of %d junctions; %v needs half or more", name, count, len(v.Junctions), v.Meter)
+	}
+}
+
+func (v *Verse) checkVenba() {
+	v.checkLines(2, 4, 0, 3)
+	v.checkEachThalai(Thalai.IsVendalai)
+	for i, line := range v.Lines {
+		for j, seer := range line.Seers {
+			final := i == len(v.Lines)-1 && j == len(line.Seers)-1
+			switch {
+			case final && seer.FinalVaaypaadu() == "":
+				v.violate(ViolationFinalSeer, i, j, "ஈற்றுச்சீர் %v (%s); expected நாள், மலர், காசு or பிறப்பு", seer.Word, seer.Vaaypaadu())
+			case !final && (len(seer.Asais) == 1 || len(seer.Asais) > 3 || seer.isKani()):
+				v.violate(ViolationSeer, i, j, "%v (%s) not allowed in %v", seer.Word, seer.Vaaypaadu(), v.Meter)
+			}
+		}
+	}
+}
+
+func (v *Verse) checkAasiriyappa() {
+	v.checkLines(3, 4, 3, 0)
+	v.checkPredominantThalai(Thalai.IsAasiriyaththalai, "ஆசிரியத்தளை")
+	for i, line := range v.Lines {
+		for j, seer := range line.Seers {
+			if seer.isKani() {
+				v.violate(ViolationSeer, i, j, "%v (%s) not allowed in %v", seer.Word, seer.Vaaypaadu(), v.Meter)
+			}
+		}
+	}
+	if n := len(v.Lines); n > 0 && len(v.Lines[n-1].Seers) > 0 {
+		seers := v.Lines[n-1].Seers
+		last := seers[len(seers)-1].Word.LastLetter()
+		if last.IsCV() {
+			_, last = last.SplitCV()
+		}
+		if !last.IsLetter("ஏ") {
+			v.violate(ViolationEnding, n-1, len(seers)-1, "%v ends in ஏ", v.Meter)
+		}
+	}
+}
+
+func (v *Verse) checkKalippa() {
+	v.checkLines(4, 4, 0, 0)
+	v.checkPredominantThalai(func(t Thalai) bool { return t == Kalithalai }, "கலித்தளை")
+	seers, kaay, kani := 0, 0, 0
+	for _, line := range v.Lines {
+		for _, seer := range line.Seers {
+			seers++
+			switch {
+			case seer.isKaay():
+				kaay++
+			case seer.isKani():
+				kani++
+			}
+		}
+	}
+	if 2*kaay < seers {
+		v.violate(ViolationSeer, -1, -1, "காய்ச்சீர் at %d of %d சீர்கள்; %v needs half or more", kaay, seers, v.Meter)
+	}
+	if kani > 0 && kani >= kaay {
+		v.violate(ViolationSeer, -1, -1, "கனிச்சீர் at %d of %d சீர்கள்; %v needs fewer than the காய்ச்சீர்", kani, seers, v.Meter)
+	}
+}
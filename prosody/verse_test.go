@@ -0,0 +1,186 @@
+package prosody_test // Black box test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ThamizhLearner/Thamizh/prosody"
+)
+
+// Couplets of the Thirukkural sample file, by their number
+func readKurals(t *testing.T) map[string]string {
+	data, err := os.ReadFile("testdata/thirukkural_sample.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kurals := map[string]string{}
+	for _, stanza := range strings.Split(string(data), "\n\n") {
+		var num string
+		var lines []string
+		for _, line := range strings.Split(strings.TrimSpace(stanza), "\n") {
+			switch {
+			case strings.HasPrefix(line, "#"):
+			case num == "":
+				num = line
+			default:
+				lines = append(lines, line)
+			}
+		}
+		if num != "" {
+			kurals[num] = strings.Join(lines, "\n")
+		}
+	}
+	return kurals
+}
+
+func TestThirukkuralSample(t *testing.T) {
+	kurals := readKurals(t)
+	if len(kurals) == 0 {
+		t.Fatal("Empty sample")
+	}
+	for num, kural := range kurals {
+		v := prosody.Validate(kural, prosody.Venba)
+		if !v.Valid() {
+			t.Errorf("Kural %s: Unexpected violations %v", num, v.Violations)
+		}
+		if len(v.Lines) != 2 || len(v.Junctions) != 6 {
+			t.Errorf("Kural %s, expected 2 lines, 6 junctions, got %d, %d", num, len(v.Lines), len(v.Junctions))
+		}
+	}
+
+}
+
+func TestVenbaAnalysis(t *testing.T) {
+	v := prosody.Validate(readKurals(t)["1"], prosody.Venba)
+	thalais := []prosody.Thalai{
+		prosody.IyarseerVendalai, prosody.IyarseerVendalai, prosody.VenseerVendalai,
+		prosody.IyarseerVendalai, // Across the lines (ஆதி => பகவன்)
+		prosody.IyarseerVendalai, prosody.IyarseerVendalai,
+	}
+	for i, j := range v.Junctions {
+		if j.Thalai != thalais[i] {
+			t.Errorf("Junction %d:%d, expected %v, got %v", j.Line, j.Seer, thalais[i], j.Thalai)
+		}
+	}
+	if j := v.Junctions[3]; j.Line != 0 || j.Seer != 3 {
+		t.Errorf("Junction position, expected 0:3, got %d:%d", j.Line, j.Seer)
+	}
+	last := v.Lines[1].Seers[2]
+	if last.FinalVaaypaadu() != "பிறப்பு" {
+		t.Errorf("ஈற்றுச்சீர் உலகு, expected பிறப்பு, got %s", last.FinalVaaypaadu())
+	}
+
+	// முற்றியலுகரம் ஈற்றுச்சீர் (அறிவு), and the குற்றியலுகரம் elided across the lines (நன்றல்லது => அன்றே)
+	if last := prosody.Validate(readKurals(t)["355"], prosody.Venba).Lines[1].Seers[2]; last.FinalVaaypaadu() != "பிறப்பு" {
+		t.Errorf("ஈற்றுச்சீர் தறிவு, expected பிறப்பு, got %s", last.FinalVaaypaadu())
+	}
+	if seer := prosody.Validate(readKurals(t)["108"], prosody.Venba).Lines[0].Seers[3]; !seer.Elided || seer.Vaaypaadu() != "தேமாங்காய்" {
+		t.Errorf("நன்றல்லது, expected elided தேமாங்காய், got %v %s", seer.Elided, seer.Vaaypaadu())
+	}
+}
+
+func TestVenbaViolations(t *testing.T) {
+	tests := []struct {
+		text       string
+		violations []prosody.Violation // Kind and position only
+	}{
+		{"அகர முதல எழுத்தெல்லாம் ஆதி\nபகவன் முதற்றே உலகம்", []prosody.Violation{
+			{Kind: prosody.ViolationFinalSeer, Line: 1, Seer: 2}, // உலகம் (கருவிளம்)
+		}},
+		{"அகர முதல எழுத்தெல்லாம் ஆதி பகவன்\nமுதற்றே உலகு", []prosody.Violation{
+			{Kind: prosody.ViolationSeerCount, Line: 0, Seer: -1},
+			{Kind: prosody.ViolationSeerCount, Line: 1, Seer: -1},
+		}},
+		{"அகர முதல உலகு", []prosody.Violation{
+			{Kind: prosody.ViolationLineCount, Line: -1, Seer: -1},
+		}},
+		{"அகர ஆதி எழுத்தெல்லாம் ஆதி\nபகவன் முதற்றே உலகு", []prosody.Violation{
+			{Kind: prosody.ViolationThalai, Line: 0, Seer: 0}, // மா முன் நேர்
+		}},
+		{"அகர முதல எழுத்தெல்லாம் ABC ஆதி\nபகவன் முதற்றே உலகு", []prosody.Violation{
+			{Kind: prosody.ViolationWord, Line: 0, Seer: 3},
+		}},
+		{"அகர முதல எழுத்தெல்லாம் ஆதி\nABC DEF\nபகவன் முதற்றே உலகு", []prosody.Violation{
+			{Kind: prosody.ViolationWord, Line: 1, Seer: 0},
+			{Kind: prosody.ViolationWord, Line: 1, Seer: 0},
+			{Kind: prosody.ViolationSeerCount, Line: 1, Seer: -1}, // The line kept, of no சீர்கள்
+		}},
+	}
+	for _, tc := range tests {
+		v := prosody.Validate(tc.text, prosody.Venba)
+		if len(v.Violations) != len(tc.violations) {
+			t.Errorf("Validate %q, expected %v, got %v", tc.text, tc.violations, v.Violations)
+			continue
+		}
+		for i, vi := range v.Violations {
+			if want := tc.violations[i]; vi.Kind != want.Kind || vi.Line != want.Line || vi.Seer != want.Seer {
+				t.Errorf("Validate %q, expected %v, got %v", tc.text, want, vi)
+			}
+		}
+	}
+}
+
+func TestAasiriyappa(t *testing.T) {
+	// குறுந்தொகை 40; நேரிசை ஆசிரியப்பா
+	verse := `யாயும் ஞாயும் யாரா கியரோ
+எந்தையும் நுந்தையும் எம்முறைக் கேளிர்
+யானும் நீயும் எவ்வழி அறிதும்
+செம்புலப் பெயல்நீர் போல
+அன்புடை நெஞ்சம் தாம்கலந் தனவே`
+	if v := prosody.Validate(verse, prosody.Aasiriyappa); !v.Valid() {
+		t.Errorf("Aasiriyappa: Unexpected violations %v", v.Violations)
+	}
+	if v := prosody.Validate(verse, prosody.Venba); v.Valid() {
+		t.Errorf("Aasiriyappa as Venba, expected violations")
+	}
+	v := prosody.Validate(strings.TrimSuffix(verse, "தனவே")+"தனர்", prosody.Aasiriyappa)
+	if len(v.Violations) != 1 || v.Violations[0].Kind != prosody.ViolationEnding {
+		t.Errorf("Aasiriyappa ending, got %v", v.Violations)
+	}
+}
+
+func TestKalippa(t *testing.T) {
+	// தரவு கொச்சகக் கலிப்பா (யாப்பருங்கலக்காரிகை உரை)
+	verse := `செல்வப்போர்க் கதக்கண்ணன் செயிர்த்தெறிந்த சினவாழி
+முல்லைத்தார் மறமன்னர் முடித்தலையை முருக்கிப்போய்
+எல்லைநீர் வியன்கொண்மூ இடைநுழையும் மதியம்போல்
+மல்லலோங் கெழில்யானை மருமம்பாய்ந் தொளிவருமே`
+	v := prosody.Validate(verse, prosody.Kalippa)
+	if !v.Valid() {
+		t.Errorf("Kalippa: Unexpected violations %v", v.Violations)
+	}
+	if j := v.Junctions[0]; j.Thalai != prosody.Kalithalai {
+		t.Errorf("Kalippa junction %d:%d, expected கலித்தளை, got %v", j.Line, j.Seer, j.Thalai)
+	}
+	if v := prosody.Validate(readKurals(t)["1"], prosody.Kalippa); v.Valid() {
+		t.Errorf("Venba as Kalippa, expected violations")
+	}
+
+	tests := []struct {
+		text       string
+		violations []prosody.Violation // Kind and position only
+	}{
+		// கலித்தளை at half the junctions, but as many கனிச்சீர் as காய்ச்சீர்
+		{strings.Repeat("புளிமாங்காய் கருவிளங்கனி புளிமாங்காய் கருவிளங்கனி\n", 4), []prosody.Violation{
+			{Kind: prosody.ViolationSeer, Line: -1, Seer: -1},
+		}},
+		// இயற்சீர் mostly
+		{strings.Repeat("புளிமா புளிமா புளிமாங்காய் புளிமா\n", 4), []prosody.Violation{
+			{Kind: prosody.ViolationThalai, Line: -1, Seer: -1},
+			{Kind: prosody.ViolationSeer, Line: -1, Seer: -1},
+		}},
+	}
+	for _, tc := range tests {
+		v := prosody.Validate(tc.text, prosody.Kalippa)
+		if len(v.Violations) != len(tc.violations) {
+			t.Errorf("Validate %q, expected %v, got %v", tc.text, tc.violations, v.Violations)
+			continue
+		}
+		for i, vi := range v.Violations {
+			if want := tc.violations[i]; vi.Kind != want.Kind || vi.Line != want.Line || vi.Seer != want.Seer {
+				t.Errorf("Validate %q, expected %v, got %v", tc.text, want, vi)
+			}
+		}
+	}
+}
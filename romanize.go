@@ -0,0 +1,166 @@
+// Thamizh string romanization (Latin transliteration)
+
+package script
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// Romanization scheme
+type RomanScheme uint8
+
+// Romanization schemes
+const (
+	// ISO 15919; diacritic based (e.g. தமிழ் => tamiḻ), lossless
+	ISO15919 RomanScheme = iota
+)
+
+// ISO 15919 romanization of the 12 vowels (அ - ஔ)
+var isoVowels = [base.VowelCount]string{
+	"a", "ā", "i", "ī", "u", "ū",
+	"e", "ē", "ai", "o", "ō", "au",
+}
+
+// ISO 15919 romanization of the consonant rows (க - ன, followed by ஜ ஶ ஷ ஸ ஹ க்ஷ)
+var isoConsonants = [base.ConsonantCount]string{
+	"k", "ṅ", "c", "ñ", "ṭ", "ṇ",
+	"t", "n", "p", "m", "y", "r",
+	"l", "v", "ḻ", "ḷ", "ṟ", "ṉ",
+	"j", "ś", "ṣ", "s", "h", "kṣ",
+}
+
+// ISO 15919 romanization of ஃ
+const isoAytham = "ḵ"
+
+// ISO 15919 separator for the otherwise ambiguous letter sequences (e.g. க + இ => ka:i, not கை kai)
+const isoSeparator = ":"
+
+// Romanizes the string as per the given scheme
+func (s String) Romanize(scheme RomanScheme) string {
+	if scheme != ISO15919 {
+		panic("Unexpected romanization scheme value")
+	}
+	var sb strings.Builder
+	var prev Letter
+	for i, letter := range s.idxs {
+		curr := Letter{idx: letter}
+		if i > 0 && isoNeedsSeparator(prev, curr) {
+			sb.WriteString(isoSeparator)
+		}
+		prev = curr
+		switch {
+		case curr.IsV():
+			sb.WriteString(isoVowels[curr.idx])
+		case curr.IsC():
+			sb.WriteString(isoConsonants[base.CRow(curr.idx)])
+		case curr.IsCV():
+			c, v := curr.SplitCV()
+			sb.WriteString(isoConsonants[base.CRow(c.idx)])
+			sb.WriteString(isoVowels[v.idx])
+		case curr.IsAytham():
+			sb.WriteString(isoAytham)
+		default: // ஸ்ரீ
+			lead, rest, _ := base.SplitConjunct(curr.idx)
+			sb.WriteString(Letter{idx: lead}.Romanize(scheme))
+			sb.WriteString(Letter{idx: rest}.Romanize(scheme))
+		}
+	}
+	return sb.String()
+}
+
+// Romanizes the letter as per the given scheme
+func (chr Letter) Romanize(scheme RomanScheme) string {
+	return String{idxs: []uint16{chr.idx}}.Romanize(scheme)
+}
+
+// Indicates the separator need between the adjacent letters
+//
+// C followed by V (which otherwise forms CV), and அ-ending letter followed by இ/உ (which otherwise forms ai/au)
+func isoNeedsSeparator(prev, curr Letter) bool {
+	if prev.IsC() && curr.IsV() {
+		return true
+	}
+	if curr.idx != 2 && curr.idx != 4 { // இ, உ
+		return false
+	}
+	if prev.IsCV() {
+		_, prev = prev.SplitCV()
+	}
+	return prev.idx == 0 // அ
+}
+
+// Romanized token of a letter
+type romanToken struct {
+	token string
+	idx   uint16
+}
+
+// ISO 15919 tokens (longest tokens first)
+var isoTokens = func() []romanToken {
+	var tokens []romanToken
+	for i, v := range isoVowels {
+		tokens = append(tokens, romanToken{v, uint16(i)})
+	}
+	for row, c := range isoConsonants {
+		tokens = append(tokens, romanToken{c, base.CIdx(uint8(row))})
+	}
+	tokens = append(tokens, romanToken{isoAytham, base.AythamIdx})
+	slices.SortStableFunc(tokens, func(a, b romanToken) int { return len(b.token) - len(a.token) })
+	return tokens
+}()
+
+// Decomposed (combining diacritic) forms of the ISO 15919 letters => Precomposed forms
+var isoComposer = strings.NewReplacer(
+	"a\u0304", "ā", "i\u0304", "ī", "u\u0304", "ū", "e\u0304", "ē", "o\u0304", "ō",
+	"n\u0307", "ṅ", "n\u0303", "ñ", "t\u0323", "ṭ", "n\u0323", "ṇ", "l\u0331", "ḻ",
+	"l\u0323", "ḷ", "r\u0331", "ṟ", "n\u0331", "ṉ", "s\u0301", "ś", "s\u0323", "ṣ",
+	"k\u0331", "ḵ",
+)
+
+// Parses the given romanized (as per the given scheme) text into Thamizh string
+//
+// Accepts lowercase letters, in precomposed or decomposed (combining diacritics) form.
+// On failure, returns *DecodeError locating the offending character (offsets within the precomposed text).
+func ParseRoman(text string, scheme RomanScheme) (String, error) {
+	if scheme != ISO15919 {
+		panic("Unexpected romanization scheme value")
+	}
+	text = isoComposer.Replace(text)
+	var idxs []uint16
+	separated := false
+	for i, runeOffset := 0, 0; i < len(text); runeOffset++ {
+		if strings.HasPrefix(text[i:], isoSeparator) {
+			i += len(isoSeparator)
+			separated = true
+			continue
+		}
+		token := ""
+		var idx uint16
+		for _, t := range isoTokens {
+			if strings.HasPrefix(text[i:], t.token) {
+				token, idx = t.token, t.idx
+				break
+			}
+		}
+		if token == "" {
+			r, _ := utf8.DecodeRuneInString(text[i:])
+			return String{}, &DecodeError{ByteOffset: i, RuneOffset: runeOffset, Rune: r, Reason: ErrUnsupportedCode}
+		}
+		if separated {
+			idxs = append(idxs, idx)
+		} else {
+			idxs = appendJoined(idxs, idx)
+		}
+		separated = false
+		i += len(token)
+		runeOffset += utf8.RuneCountInString(token) - 1
+	}
+	if idxs == nil {
+		return String{}, &DecodeError{Reason: ErrEmptyString}
+	}
+	return String{idxs: idxs}, nil
+}
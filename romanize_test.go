@@ -0,0 +1,61 @@
+package script_test // Black box test
+
+import (
+	"errors"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestRomanize(t *testing.T) {
+	tests := []struct {
+		ustr  string
+		roman string
+	}{
+		{"தமிழ்", "tamiḻ"},
+		{"வணக்கம்", "vaṇakkam"},
+		{"பொன்னாடை", "poṉṉāṭai"},
+		{"எஃகு", "eḵku"},
+		{"கௌரவம்", "kauravam"},
+		{"சங்கம்", "caṅkam"},
+		{"கஇ", "ka:i"},
+		{"அஉ", "a:u"},
+		{"க்அ", "k:a"},
+		{"ஸ்ரீ", "srī"},
+		{"லக்ஷ்மி", "lakṣmi"},
+		{"ஜனவரி", "jaṉavari"},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		if got := s.Romanize(script.ISO15919); got != tc.roman {
+			t.Errorf("Romanize %s, expected %s, got %s", tc.ustr, tc.roman, got)
+		}
+		if got, err := script.ParseRoman(tc.roman, script.ISO15919); err != nil || got.String() != tc.ustr {
+			t.Errorf("Parse roman %s, expected %s, got %s (%v)", tc.roman, tc.ustr, got, err)
+		}
+	}
+
+	// Lossless round trip of every letter, and of every letter pair
+	letters := append(nativeLetterUStrs(), "ஜ", "ஶ்", "ஷா", "ஸி", "ஹௌ", "க்ஷை", "ஸ்ரீ")
+	for _, a := range letters {
+		for _, b := range append([]string{""}, letters...) {
+			s := script.MustDecode(a + b)
+			got, err := script.ParseRoman(s.Romanize(script.ISO15919), script.ISO15919)
+			if err != nil || got.String() != s.String() {
+				t.Fatalf("Roman round trip %s (%s), got %s (%v)", s, s.Romanize(script.ISO15919), got, err)
+			}
+		}
+	}
+
+	if got, err := script.ParseRoman("tami\u1E3B", script.ISO15919); err != nil || got.String() != "தமிழ்" {
+		t.Errorf("Parse roman precomposed, got %s (%v)", got, err)
+	}
+	if got, err := script.ParseRoman("ta\u0304", script.ISO15919); err != nil || got.String() != "தா" {
+		t.Errorf("Parse roman decomposed, got %s (%v)", got, err)
+	}
+	_, err := script.ParseRoman("tamil x", script.ISO15919)
+	var decErr *script.DecodeError
+	if !errors.As(err, &decErr) || decErr.ByteOffset != 5 || decErr.RuneOffset != 5 || decErr.Rune != ' ' {
+		t.Errorf("Parse roman error, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+// Sandhi (புணர்ச்சி) engine; joining words as per the Thamizh joining rules
+
+package script
+
+import (
+	"strings"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// Sandhi rule (புணர்ச்சி விதி); rules combine (|) into a rule set
+type SandhiRule uint8
+
+// Sandhi rules, applied (at most one per join) in the listed order of precedence; but SandhiMDrop, when switched on,
+// takes precedence over SandhiNasal (மரம் + கிளை => மரக்கிளை)
+const (
+	// உயிர் முன் குற்றியலுகரம் கெடுதல்: word-final குற்றியலுகரம் elides before a vowel (நாடு + அழகு => நாடழகு)
+	SandhiUElision SandhiRule = 1 << iota
+
+	// உடம்படுமெய்: ய் (after இ ஈ ஐ ஏ) or வ் (after the other vowels) joins the vowels (மணி + அடி => மணியடி)
+	SandhiGlide
+
+	// மகரத் திரிபு: word-final ம் assimilates to the following க/ச/த as ங்/ஞ்/ந் (மரம் + கள் => மரங்கள்)
+	SandhiNasal
+
+	// லகர ளகரத் திரிபு: word-final ல்/ள் becomes ற்/ட் before வல்லினம், turning the following த into ற/ட
+	// (கல் + தூண் => கற்றூண்; முள் + செடி => முட்செடி)
+	SandhiLateral
+
+	// வலி மிகுதல்: the following வல்லினம் (க ச த ப) doubles after the accusative ஐ, the dative கு,
+	// the adverbial ஆக/ஆய், and the demonstratives அந்த இந்த எந்த (பாடத்தை + படி => பாடத்தைப்படி)
+	SandhiDoubling
+
+	// தனிக்குறில் முன் ஒற்று இரட்டுதல்: the consonant after a lone short letter doubles before a vowel
+	// (பொன் + ஆடை => பொன்னாடை); opt-in
+	SandhiGemination
+
+	// மகர ஈறு கெட்டு வலி மிகுதல்: word-final ம் drops and the following வல்லினம் doubles (மரம் + கிளை => மரக்கிளை);
+	// opt-in
+	SandhiMDrop
+
+	AllSandhiRules = SandhiUElision | SandhiGlide | SandhiNasal | SandhiLateral | SandhiDoubling | SandhiGemination | SandhiMDrop
+
+	// Rules applying only when switched on (SandhiOptions.Enabled)
+	OptInSandhiRules = SandhiGemination | SandhiMDrop
+)
+
+// Stringer interface implementation
+func (r SandhiRule) String() string {
+	var names []string
+	for _, rule := range []struct {
+		rule SandhiRule
+		name string
+	}{
+		{SandhiUElision, "UElision (உகரம் கெடுதல்)"},
+		{SandhiGlide, "Glide (உடம்படுமெய்)"},
+		{SandhiNasal, "Nasal (மகரத் திரிபு)"},
+		{SandhiLateral, "Lateral (லகர ளகரத் திரிபு)"},
+		{SandhiDoubling, "Doubling (வலி மிகுதல்)"},
+		{SandhiGemination, "Gemination (ஒற்று இரட்டுதல்)"},
+		{SandhiMDrop, "MDrop (மகர ஈறு கெடுதல்)"},
+	} {
+		if r&rule.rule != 0 {
+			names = append(names, rule.name)
+		}
+	}
+	return strings.Join(names, " | ")
+}
+
+// Sandhi join options
+type SandhiOptions struct {
+	Disabled SandhiRule // Rules switched off; all rules but the opt-in ones apply by default
+	Enabled  SandhiRule // Opt-in rules (OptInSandhiRules) switched on
+}
+
+// Joins the two words as per the (enabled) sandhi rules; Append, when none applies
+//
+// Returns the joined string and the trace of the rules fired.
+func Join(a, b String, opts SandhiOptions) (String, []SandhiRule) {
+	enabled := func(rule SandhiRule) bool {
+		return opts.Disabled&rule == 0 && (OptInSandhiRules&rule == 0 || opts.Enabled&rule != 0)
+	}
+	first := b.FirstLetter()
+	switch {
+	case first.IsV():
+		if enabled(SandhiUElision) && a.EndsWithKutriyalukaram() {
+			trimmed, _ := a.TrimEnd(String{idxs: []uint16{uint16(base.ColU)}})
+			return trimmed.Append(b), []SandhiRule{SandhiUElision}
+		}
+		if col, ok := a.finalVowelCol(); ok && enabled(SandhiGlide) {
+			glide := MustNewLetter("வ்")
+			if col == colI || col == colII || col == colAi || col == colEe {
+				glide = MustNewLetter("ய்")
+			}
+			return a.Append(glide.Str()).Append(b), []SandhiRule{SandhiGlide}
+		}
+		if enabled(SandhiGemination) && a.Len() == 2 && a.FirstLetter().IsShortVocal() && a.LastLetter().IsC() {
+			return a.Append(a.LastLetter().Str()).Append(b), []SandhiRule{SandhiGemination}
+		}
+	case first.IsCV() && first.IsStrongVocal():
+		c, _ := first.SplitCV()
+		last := a.LastLetter()
+		switch {
+		case last.IsLetter("ம்") && a.Len() > 1 && enabled(SandhiMDrop): // Ahead of SandhiNasal, when switched on
+			a, _ = a.ReplaceEnd(last.Str(), c.Str())
+			return a.Append(b), []SandhiRule{SandhiMDrop}
+		case last.IsLetter("ம்") && enabled(SandhiNasal):
+			if nasal, ok := sandhiNasals[base.CRow(c.idx)]; ok {
+				a, _ = a.ReplaceEnd(last.Str(), MustDecode(nasal))
+				return a.Append(b), []SandhiRule{SandhiNasal}
+			}
+		case (last.IsLetter("ல்") || last.IsLetter("ள்")) && enabled(SandhiLateral):
+			stop := MustNewLetter("ற்")
+			if last.IsLetter("ள்") {
+				stop = MustNewLetter("ட்")
+			}
+			a, _ = a.ReplaceEnd(last.Str(), stop.Str())
+			if c.IsLetter("த்") {
+				b, _ = b.ReplaceStart(c.Str(), stop.Str())
+			}
+			return a.Append(b), []SandhiRule{SandhiLateral}
+		case enabled(SandhiDoubling) && a.triggersDoubling():
+			return a.Append(c.Str()).Append(b), []SandhiRule{SandhiDoubling}
+		}
+	}
+	return a.Append(b), nil
+}
+
+// Vowel columns of interest
+const (
+	colI  = 2 // இ
+	colII = 3 // ஈ
+	colEe = 7 // ஏ
+	colAi = 8 // ஐ
+)
+
+// Nasals assimilating word-final ம், per the following வல்லினம் row (க ச த)
+var sandhiNasals = map[uint8]string{base.RowKa: "ங்", base.RowCa: "ஞ்", base.RowTa: "ந்"}
+
+// Demonstratives doubling the following வல்லினம்
+var sandhiDemonstratives = []string{"அந்த", "இந்த", "எந்த"}
+
+// Vowel column of the word-final vowel sound (V, CV or ஸ்ரீ); false on a word-final consonant/aytham
+func (s String) finalVowelCol() (uint8, bool) {
+	last := s.LastLetter()
+	if _, rest, ok := base.SplitConjunct(last.idx); ok && !base.IsC(rest) {
+		last = Letter{idx: rest}
+	}
+	switch {
+	case last.IsV():
+		return uint8(last.idx), true
+	case last.IsCV():
+		_, v := last.SplitCV()
+		return uint8(v.idx), true
+	}
+	return 0, false
+}
+
+// Indicates the word doubles the following வல்லினம் (ஐ, கு, ஆக, ஆய், demonstratives)
+func (s String) triggersDoubling() bool {
+	for _, d := range sandhiDemonstratives {
+		if s.String() == d {
+			return true
+		}
+	}
+	if col, ok := s.finalVowelCol(); ok && col == colAi {
+		return true
+	}
+	for _, suffix := range []string{"கு", "ஆக", "ஆய்"} {
+		if s.Len() > 1 && s.HasSuffix(MustDecode(suffix)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package script_test // Black box test
+
+import (
+	"slices"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		ua, ub   string
+		disabled script.SandhiRule
+		ures     string
+		trace    []script.SandhiRule
+	}{
+		{"நாடு", "அழகு", 0, "நாடழகு", []script.SandhiRule{script.SandhiUElision}},
+		{"நாடு", "அழகு", script.SandhiUElision, "நாடுவழகு", []script.SandhiRule{script.SandhiGlide}},
+		{"மணி", "அடி", 0, "மணியடி", []script.SandhiRule{script.SandhiGlide}},
+		{"பனை", "ஓலை", 0, "பனையோலை", []script.SandhiRule{script.SandhiGlide}},
+		{"பல", "இடம்", 0, "பலவிடம்", []script.SandhiRule{script.SandhiGlide}},
+		{"திரு", "அருள்", 0, "திருவருள்", []script.SandhiRule{script.SandhiGlide}},
+		{"மணி", "அடி", script.AllSandhiRules, "மணிஅடி", nil},
+		{"மரம்", "கள்", 0, "மரங்கள்", []script.SandhiRule{script.SandhiNasal}},
+		{"பழம்", "சாறு", 0, "பழஞ்சாறு", []script.SandhiRule{script.SandhiNasal}},
+		{"பணம்", "தா", 0, "பணந்தா", []script.SandhiRule{script.SandhiNasal}},
+		{"மரம்", "பலகை", 0, "மரம்பலகை", nil},
+		{"கல்", "தூண்", 0, "கற்றூண்", []script.SandhiRule{script.SandhiLateral}},
+		{"பல்", "பொடி", 0, "பற்பொடி", []script.SandhiRule{script.SandhiLateral}},
+		{"முள்", "செடி", 0, "முட்செடி", []script.SandhiRule{script.SandhiLateral}},
+		{"பாடத்தை", "படி", 0, "பாடத்தைப்படி", []script.SandhiRule{script.SandhiDoubling}},
+		{"வீட்டுக்கு", "போ", 0, "வீட்டுக்குப்போ", []script.SandhiRule{script.SandhiDoubling}},
+		{"அந்த", "கடை", 0, "அந்தக்கடை", []script.SandhiRule{script.SandhiDoubling}},
+		{"நன்றாக", "பேசு", 0, "நன்றாகப்பேசு", []script.SandhiRule{script.SandhiDoubling}},
+		{"அந்த", "கடை", script.SandhiDoubling, "அந்தகடை", nil},
+		{"மணி", "கண்டு", 0, "மணிகண்டு", nil},
+		{"தமிழ்", "இனிது", 0, "தமிழினிது", nil},
+	}
+	for _, tc := range tests {
+		a, b := script.MustDecode(tc.ua), script.MustDecode(tc.ub)
+		got, trace := script.Join(a, b, script.SandhiOptions{Disabled: tc.disabled})
+		if got.String() != tc.ures || !slices.Equal(trace, tc.trace) {
+			t.Errorf("Join %s + %s, expected %s %v, got %s %v", tc.ua, tc.ub, tc.ures, tc.trace, got, trace)
+		}
+		if a.String() != tc.ua || b.String() != tc.ub {
+			t.Errorf("Join %s + %s mutated its inputs, got %s + %s", tc.ua, tc.ub, a, b)
+		}
+	}
+
+	if got := (script.SandhiGlide | script.SandhiNasal).String(); got != "Glide (உடம்படுமெய்) | Nasal (மகரத் திரிபு)" {
+		t.Errorf("Sandhi rule names, got %s", got)
+	}
+}
+
+func TestJoinOptInRules(t *testing.T) {
+	tests := []struct {
+		ua, ub            string
+		enabled, disabled script.SandhiRule
+		ures              string
+		trace             []script.SandhiRule
+	}{
+		{"மரம்", "பலகை", script.SandhiMDrop, 0, "மரப்பலகை", []script.SandhiRule{script.SandhiMDrop}},
+		{"மரம்", "கிளை", script.SandhiMDrop, script.SandhiNasal, "மரக்கிளை", []script.SandhiRule{script.SandhiMDrop}},
+		{"மரம்", "பலகை", script.OptInSandhiRules, script.SandhiMDrop, "மரம்பலகை", nil},
+		{"மரம்", "கிளை", script.SandhiMDrop, 0, "மரக்கிளை", []script.SandhiRule{script.SandhiMDrop}}, // Ahead of SandhiNasal
+		{"மரம்", "கிளை", 0, 0, "மரங்கிளை", []script.SandhiRule{script.SandhiNasal}},
+		{"பொன்", "ஆடை", script.SandhiGemination, 0, "பொன்னாடை", []script.SandhiRule{script.SandhiGemination}},
+		{"கல்", "எறி", script.SandhiGemination, 0, "கல்லெறி", []script.SandhiRule{script.SandhiGemination}},
+		{"தமிழ்", "அழகு", script.SandhiGemination, 0, "தமிழழகு", nil},
+		{"பொன்", "ஆடை", 0, 0, "பொனாடை", nil}, // Off by default
+	}
+	for _, tc := range tests {
+		got, trace := script.Join(script.MustDecode(tc.ua), script.MustDecode(tc.ub), script.SandhiOptions{Disabled: tc.disabled, Enabled: tc.enabled})
+		if got.String() != tc.ures || !slices.Equal(trace, tc.trace) {
+			t.Errorf("Join %s + %s (%v), expected %s %v, got %s %v", tc.ua, tc.ub, tc.enabled, tc.ures, tc.trace, got, trace)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Default suffix rules (data) of the Thamizh stemmer
+
+package stemmer
+
+import (
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+// Remainder endings (before repair) of the vowel-initial case markers; oblique, glide and final consonants
+//
+// Note: ல் is left out, not to take ஐ-final nouns (மலை) for accusative; கடலில் stays unstemmed
+var caseEnds = []string{
+	"ட்ட்", "ற்ற்", "த்த்", // Oblique stems (வீட்டில், ஆற்றில், மரத்தில்)
+	"ண்ண்", "ன்ன்", "ல்ல்", "ள்ள்", // Geminated after lone short letter (கண்ணில்)
+	"ன்", "ள்", "ர்", "ழ்", "ண்", "ய்", "வ்",
+}
+
+// Remainder endings (before repair) of the vowel-initial clitics
+var cliticEnds = []string{
+	"ன்", "ள்", "ர்", "ல்", "ழ்", "ண்", "ம்", "ய்", "வ்",
+	"க்", "ச்", "ட்", "த்", "ப்", "ற்", // குற்றியலுகரம் (வீடே)
+}
+
+// Noun case markers (வேற்றுமை உருபுகள்)
+var caseMarkers = []Rule{
+	{Suffix: "இலிருந்து", Class: Case, After: caseEnds},
+	{Suffix: "இடமிருந்து", Class: Case, After: caseEnds},
+	{Suffix: "இடம்", Class: Case, After: caseEnds},
+	{Suffix: "உடைய", Class: Case, After: caseEnds},
+	{Suffix: "உக்கு", Class: Case, After: caseEnds},
+	{Suffix: "உடன்", Class: Case, After: caseEnds},
+	{Suffix: "ஓடு", Class: Case, After: caseEnds},
+	{Suffix: "ஆல்", Class: Case, After: caseEnds},
+	{Suffix: "இல்", Class: Case, After: caseEnds},
+	{Suffix: "இன்", Class: Case, After: caseEnds},
+	{Suffix: "ஐ", Class: Case, After: caseEnds},
+	{Suffix: "க்கு", Class: Case},
+}
+
+// Plural markers
+var pluralMarkers = []Rule{
+	{Suffix: "ங்கள்", Replace: "ம்", Class: Plural}, // மரங்கள் => மரம்
+	{Suffix: "க்கள்", Class: Plural},                // பூக்கள் => பூ
+	{Suffix: "கள்", Class: Plural},
+}
+
+// Clitics (இடைச்சொற்கள்)
+var clitics = []Rule{
+	{Suffix: "உம்", Class: Clitic, After: cliticEnds},
+	{Suffix: "ஏ", Class: Clitic, After: cliticEnds},
+	{Suffix: "ஓ", Class: Clitic, After: cliticEnds},
+	{Suffix: "ஆ", Class: Clitic, After: cliticEnds},
+}
+
+// Verb tense markers (இடைநிலைகள்), preceding the person-number-gender endings
+var tenseMarkers = []string{
+	"க்கிற்", "கிற்", "க்கின்ற்", "கின்ற்", // Present
+	"த்த்", "ந்த்", "இன்", // Past
+	"ப்ப்", "வ்", // Future
+}
+
+// Verb person-number-gender endings (விகுதிகள்); the honorific/plural கள் goes as plural marker
+var pngEndings = []string{
+	"ஆன்", "ஆள்", "ஆர்", "அது", "அன", "ஓம்", "ஆய்", "ஈர்", "ஏன்",
+}
+
+// Verb endings; tense marker followed by person-number-gender ending (படித்தான் => படி)
+func verbEndings() []Rule {
+	var rules []Rule
+	for _, t := range tenseMarkers {
+		for _, png := range pngEndings {
+			suffix := script.MustDecode(t).Append(script.MustDecode(png)) // த்த் + ஆன் => த்தான்
+			rules = append(rules, Rule{Suffix: suffix.String(), Class: Verb})
+		}
+	}
+	return rules
+}
+
+// Default suffix rules
+var DefaultRules = func() []Rule {
+	var rules []Rule
+	rules = append(rules, clitics...)
+	rules = append(rules, caseMarkers...)
+	rules = append(rules, pluralMarkers...)
+	return append(rules, verbEndings()...)
+}()
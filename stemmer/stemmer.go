@@ -0,0 +1,208 @@
+// Rule-based Thamizh suffix stemmer, for search indexing
+
+package stemmer
+
+import (
+	"cmp"
+	"slices"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+// Suffix class
+type Class uint8
+
+// Suffix classes, stripped in the listed order (outermost first)
+const (
+	Clitic Class = iota // உம், ஏ, ஓ, ஆ
+	Case                // Case markers (வேற்றுமை உருபுகள்); ஐ, ஆல், க்கு, இல்...
+	Plural              // கள்
+	Verb                // Tense marker + person-number-gender ending; stripped when no case marker is
+	classCount
+)
+
+// Stringer interface implementation
+func (c Class) String() string {
+	switch c {
+	case Clitic:
+		return "Clitic"
+	case Case:
+		return "Case"
+	case Plural:
+		return "Plural"
+	case Verb:
+		return "Verb"
+	default:
+		panic("Unexpected suffix class value")
+	}
+}
+
+// Suffix stripping rule
+type Rule struct {
+	Suffix  string   // Thamizh Unicode suffix
+	Replace string   // Replacement of the suffix (e.g. ங்கள் => ம்); empty to just strip it
+	Class   Class    // Suffix class
+	After   []string // Allowed remainder endings (before repair); any, when empty
+}
+
+// Compiled suffix stripping rule
+type rule struct {
+	suffix  script.String
+	replace script.String
+	trim    bool // Empty replacement
+	class   Class
+	after   []script.String
+}
+
+// Removed suffix
+type Suffix struct {
+	Str   script.String
+	Class Class
+}
+
+// Stemming result
+type Result struct {
+	Stem    script.String
+	Removed []Suffix // Removed suffixes, outermost first
+}
+
+// Thamizh suffix stemmer; deterministic, driven by its suffix rules
+type Stemmer struct {
+	rules [classCount][]rule // Per class, longest suffix first
+}
+
+// Creates the stemmer of the given suffix rules
+func New(rules []Rule) *Stemmer {
+	st := &Stemmer{}
+	for _, r := range rules {
+		cr := rule{suffix: script.MustDecode(r.Suffix), trim: r.Replace == "", class: r.Class}
+		if !cr.trim {
+			cr.replace = script.MustDecode(r.Replace)
+		}
+		for _, end := range r.After {
+			cr.after = append(cr.after, script.MustDecode(end))
+		}
+		st.rules[r.Class] = append(st.rules[r.Class], cr)
+	}
+	for _, rules := range st.rules {
+		slices.SortStableFunc(rules, func(a, b rule) int { return cmp.Compare(b.suffix.Len(), a.suffix.Len()) })
+	}
+	return st
+}
+
+// Stemmer of the default suffix rules
+var Default = New(DefaultRules)
+
+// Stems the word as per the default suffix rules
+func Stem(word script.String) Result { return Default.Stem(word) }
+
+// Stems the word; strips a clitic, case marker, plural marker (also honorific; படிக்கிறார்கள்) and verb ending, in that order
+func (st *Stemmer) Stem(word script.String) Result {
+	res := Result{Stem: word}
+	for class := range classCount {
+		if class == Verb && slices.ContainsFunc(res.Removed, func(s Suffix) bool { return s.Class == Case }) {
+			break
+		}
+		for _, r := range st.rules[class] {
+			if stem, ok := r.strip(res.Stem); ok {
+				res.Stem = stem
+				res.Removed = append(res.Removed, Suffix{Str: r.suffix, Class: class})
+				break // At most one suffix per class
+			}
+		}
+	}
+	return res
+}
+
+// Attempts stripping the rule's suffix off the word, repairing the remainder into a plausible stem
+func (r rule) strip(word script.String) (script.String, bool) {
+	var stem script.String
+	var ok bool
+	if r.trim {
+		stem, ok = word.TrimEnd(r.suffix)
+	} else {
+		stem, ok = word.ReplaceEnd(r.suffix, r.replace)
+	}
+	if !ok || stem.Len() == 0 {
+		return word, false
+	}
+	if len(r.after) > 0 && !slices.ContainsFunc(r.after, stem.HasSuffix) {
+		return word, false
+	}
+	if r.trim && r.suffix.FirstLetter().IsV() {
+		stem = repair(stem, r.class)
+	}
+	if r.class == Case {
+		stem = oblique(stem)
+	}
+	return stem, isPlausibleStem(stem)
+}
+
+// Repairs the remainder, off the vowel-initial suffix (merged with the remainder's final consonant)
+func repair(stem script.String, class Class) script.String {
+	last := stem.LastLetter()
+	if !last.IsC() || stem.Len() < 2 {
+		return stem
+	}
+	prev := stem.LetterAt(stem.Len() - 2)
+	switch {
+	case isGlide(prev, last): // உடம்படுமெய் (கையில் => கை)
+		return dropLast(stem)
+	case class == Case && stem.Len() == 3 && stem.FirstLetter().IsShortVocal() && prev.Is(last) && !last.IsStrongVocal():
+		return dropLast(stem) // Geminated after lone short letter (கண்ணில் => கண்)
+	case last.IsStrongVocal(): // குற்றியலுகரம் (வீடும் => வீடு)
+		return stem.Append(script.MustDecode("உ"))
+	}
+	return stem
+}
+
+// Restores the noun stem off its oblique form (மரத்து => மரம்; வீட்டு => வீடு)
+func oblique(stem script.String) script.String {
+	if stem.Len() > 3 && stem.HasSuffix(script.MustDecode("த்து")) {
+		stem, _ = stem.ReplaceEnd(script.MustDecode("த்து"), script.MustDecode("ம்"))
+		return stem
+	}
+	n := stem.Len()
+	if n < 3 || !stem.LetterAt(n-1).IsCV() || !stem.LetterAt(n-1).IsStrongVocal() {
+		return stem
+	}
+	last, double, before := stem.LetterAt(n-1), stem.LetterAt(n-2), stem.LetterAt(n-3)
+	if c, v := last.SplitCV(); c.Is(double) && v.IsLetter("உ") && before.IsLongVocal() {
+		undoubled, _ := stem.TrimEnd(script.MustDecode(double.String() + last.String()))
+		return undoubled.Append(script.MustDecode(last.String()))
+	}
+	return stem
+}
+
+// Indicates உடம்படுமெய் (ய் after இ ஈ ஐ ஏ, வ் after the other vowels)
+func isGlide(prev, last script.Letter) bool {
+	var v script.Letter
+	switch {
+	case prev.IsV():
+		v = prev
+	case prev.IsCV():
+		_, v = prev.SplitCV()
+	default:
+		return false
+	}
+	yGlide := v.IsLetter("இ") || v.IsLetter("ஈ") || v.IsLetter("ஐ") || v.IsLetter("ஏ")
+	return last.IsLetter("ய்") && yGlide || last.IsLetter("வ்") && !yGlide
+}
+
+func dropLast(s script.String) script.String {
+	trimmed, _ := s.TrimEnd(script.MustDecode(s.LastLetter().String()))
+	return trimmed
+}
+
+// Indicates a plausible stem; not ending in consonant cluster or வல்லினம் consonant, and no lone short letter
+func isPlausibleStem(stem script.String) bool {
+	n := stem.Len()
+	last := stem.LastLetter()
+	switch {
+	case n == 1:
+		return last.IsLongVocal()
+	case last.IsC() && (last.IsStrongVocal() || stem.LetterAt(n-2).IsC()):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package stemmer_test // Black box test
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+	"github.com/ThamizhLearner/Thamizh/stemmer"
+)
+
+// Test corpus of the inflection families (stem: forms...)
+func TestStemCorpus(t *testing.T) {
+	f, err := os.Open("testdata/families.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		stem, forms, ok := strings.Cut(line, ":")
+		if !ok {
+			t.Fatalf("Invalid corpus line %q", line)
+		}
+		for _, form := range strings.Fields(forms) {
+			res := stemmer.Stem(script.MustDecode(form))
+			if res.Stem.String() != stem {
+				t.Errorf("Stem %s, expected %s, got %s (removed %v)", form, stem, res.Stem, res.Removed)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStemRemoved(t *testing.T) {
+	tests := []struct {
+		ustr    string
+		removed []string
+		classes []stemmer.Class
+	}{
+		{"வீடுகளையும்", []string{"உம்", "ஐ", "கள்"}, []stemmer.Class{stemmer.Clitic, stemmer.Case, stemmer.Plural}},
+		{"படித்தானா", []string{"ஆ", "த்தான்"}, []stemmer.Class{stemmer.Clitic, stemmer.Verb}},
+		{"மரங்கள்", []string{"ங்கள்"}, []stemmer.Class{stemmer.Plural}},
+		{"மலை", nil, nil},
+	}
+	for _, tc := range tests {
+		res := stemmer.Stem(script.MustDecode(tc.ustr))
+		if len(res.Removed) != len(tc.removed) {
+			t.Errorf("Stem %s, expected removed %v, got %v", tc.ustr, tc.removed, res.Removed)
+			continue
+		}
+		for i, s := range res.Removed {
+			if s.Str.String() != tc.removed[i] || s.Class != tc.classes[i] {
+				t.Errorf("Stem %s, removed at %d, expected %s (%v), got %s (%v)", tc.ustr, i, tc.removed[i], tc.classes[i], s.Str, s.Class)
+			}
+		}
+	}
+}
+
+func TestCustomRules(t *testing.T) {
+	st := stemmer.New([]stemmer.Rule{{Suffix: "ஆர்", Class: stemmer.Case}})
+	if got := st.Stem(script.MustDecode("அவர்கள்ஆர்")).Stem; got.String() != "அவர்கள்" {
+		t.Errorf("Custom rules stem, expected அவர்கள், got %s", got)
+	}
+}
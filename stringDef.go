@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 
+	base "github.com/ThamizhLearner/Thamizh/internal"
 	"github.com/ThamizhLearner/Thamizh/internal/unicode"
 )
 
@@ -20,9 +21,26 @@ import (
 
 // The Thamizh letter string
 type String struct {
-	idxs []uint8
+	idxs []uint16
 }
 
+// Thamizh Unicode string decode error, locating the offending code point (byte/rune offsets)
+type DecodeError = unicode.DecodeError
+
+// Reason for Thamizh Unicode string decode failure
+type DecodeErrReason = unicode.DecodeErrReason
+
+// Thamizh Unicode string decode failure reasons
+const (
+	ErrUnsupportedCode               = unicode.ErrUnsupportedCode               // Not a Thamizh letter code point
+	ErrVowelSignWithoutBaseConsonant = unicode.ErrVowelSignWithoutBaseConsonant // Vowel sign without base consonant
+	ErrDotAfterVowel                 = unicode.ErrDotAfterVowel                 // Pulli after vowel or vowel sign
+	ErrDotWithoutBaseConsonant       = unicode.ErrDotWithoutBaseConsonant       // Pulli without base consonant
+	ErrDoubleVowelSign               = unicode.ErrDoubleVowelSign               // Vowel sign after vowel sign
+	ErrEmptyString                   = unicode.ErrEmptyString                   // Zero-length string
+	ErrStrayLengthMark               = unicode.ErrStrayLengthMark               // Au length mark not completing ஔ/ௌ
+)
+
 // Attempts decoding the given Thamizh Unicode string
 func Decode(ustr string) (String, bool) {
 	idxs := unicode.Decode(ustr)
@@ -32,6 +50,17 @@ func Decode(ustr string) (String, bool) {
 	return String{idxs: idxs}, true
 }
 
+// Attempts decoding the given Thamizh Unicode string
+//
+// On failure, returns *DecodeError locating the offending code point
+func DecodeErr(ustr string) (String, error) {
+	idxs, err := unicode.DecodeErr(ustr)
+	if err != nil {
+		return String{}, err
+	}
+	return String{idxs: idxs}, nil
+}
+
 // Decodes the given (structurally valid) Thamizh Unicode string
 //
 // Use it for Thamizh Unicode literals in code, which are expected to be valid Thamizh Unicode strings.
@@ -71,7 +100,7 @@ func mapper[V, U any](s iter.Seq[V], mapFn func(V) U) iter.Seq[U] {
 
 // Iterator over letters making up Thamizh String object
 func (sa String) Letters() iter.Seq[Letter] {
-	mapp := func(idx uint8) Letter { return Letter{idx: idx} }
+	mapp := func(idx uint16) Letter { return Letter{idx: idx} }
 	return mapper(slices.Values(sa.idxs), mapp)
 }
 
@@ -95,25 +124,27 @@ func (s String) TrimEnd(trim String) (String, bool) {
 		return String{idxs: s.idxs[:matchIdx]}, true // Reusing the original slice!
 	}
 
-	// Check if we have CV and V match...
+	// Check if we have CV and V match, or Grantha conjunct and its trailing part match...
 	suffix0 := trim.FirstLetter()
-	if !suffix0.IsV() {
-		return s, false
-	}
 	str0 := s.LetterAt(matchIdx)
-	if !str0.IsCV() {
-		return s, false
-	}
-	c, v := str0.SplitCV()
-	if v.idx != suffix0.idx {
+	var lead uint16
+	if lead0, rest0, ok := base.SplitConjunct(str0.idx); ok && rest0 == suffix0.idx {
+		lead = lead0
+	} else if suffix0.IsV() && str0.IsCV() {
+		c, v := str0.SplitCV()
+		if v.idx != suffix0.idx {
+			return s, false
+		}
+		lead = c.idx
+	} else {
 		return s, false
 	}
 	// Carefully compose the trimmed string
 	// Make a copy of the slice, since we are going to modify it
-	matchIdx++ // Account for the overlapping CV/V letters
-	idxs2 := make([]uint8, matchIdx)
+	matchIdx++ // Account for the overlapping letters
+	idxs2 := make([]uint16, matchIdx)
 	copy(idxs2, s.idxs[:matchIdx])
-	idxs2[matchIdx-1] = c.idx
+	idxs2[matchIdx-1] = lead
 	return String{idxs: idxs2}, true
 }
 
@@ -126,9 +157,172 @@ func (s String) ReplaceEnd(trim String, append String) (String, bool) {
 	return st.Append(append), true
 }
 
+// Indicates the string ends with the given suffix (as per TrimEnd)
+func (s String) HasSuffix(suffix String) bool {
+	_, ok := s.TrimEnd(suffix)
+	return ok
+}
+
+// Indicates the string ends with குற்றியலுகரம்
+//
+// Final உ on a strong consonant (கு சு டு து பு று), unless the string is just a short letter followed by it (e.g. பசு).
+func (s String) EndsWithKutriyalukaram() bool {
+	last := s.LastLetter()
+	if !last.IsCV() || !last.IsStrongVocal() {
+		return false
+	}
+	if _, v := last.SplitCV(); v.idx != uint16(base.ColU) {
+		return false
+	}
+	n := s.Len()
+	return n > 2 || n == 2 && !s.FirstLetter().IsShortVocal()
+}
+
+// Attempts matching the given trim at the start, and on match trims that off
+//
+// Trailing C (on the trim) also matches the consonant half of CV letter (க் is a letter-prefix of கா, leaving ஆ).
+// Similarly trailing க்/ஸ் matches the leading half of Grantha conjunct letter (க் of க்ஷா, leaving ஷா).
+func (s String) TrimStart(trim String) (String, bool) {
+	strLen, trimLen := len(s.idxs), len(trim.idxs)
+	if strLen < trimLen {
+		return s, false
+	}
+	matchIdx := trimLen - 1 // Index of the last trim letter, along the given string
+	// Simple idx match should do! Except for the last idx.
+	for i, v := range trim.idxs[:matchIdx] {
+		if s.idxs[i] != v {
+			return s, false
+		}
+	}
+	if s.idxs[matchIdx] == trim.idxs[matchIdx] {
+		return String{idxs: s.idxs[trimLen:]}, true // Reusing the original slice!
+	}
+
+	// Check if we have C and CV match, or Grantha conjunct and its leading part match...
+	rest, ok := splitLead(s.idxs[matchIdx], trim.idxs[matchIdx])
+	if !ok {
+		return s, false
+	}
+	// Carefully compose the trimmed string
+	// Make a copy of the slice, since we are going to modify it
+	idxs2 := make([]uint16, strLen-matchIdx)
+	copy(idxs2, s.idxs[matchIdx:])
+	idxs2[0] = rest
+	return String{idxs: idxs2}, true
+}
+
+// Attempts matching the given trim at the start, and on match replaces that with given replacement string
+func (s String) ReplaceStart(trim String, prepend String) (String, bool) {
+	st, ok := s.TrimStart(trim)
+	if !ok {
+		return s, false
+	}
+	return prepend.Append(st), true
+}
+
+// Indicates the string starts with the given prefix (as per TrimStart)
+func (s String) HasPrefix(prefix String) bool {
+	_, ok := s.TrimStart(prefix)
+	return ok
+}
+
+// Longest common prefix (as per TrimStart) of the two strings; false when there is none
+//
+// Mismatching letters still share their common leading consonant (படி, பாடு => ப்).
+func (s String) CommonPrefix(s2 String) (String, bool) {
+	n := 0 // Count of the matching leading letters
+	for n < len(s.idxs) && n < len(s2.idxs) && s.idxs[n] == s2.idxs[n] {
+		n++
+	}
+	if n < len(s.idxs) && n < len(s2.idxs) {
+		if lead, ok := commonPart(leadParts(s.idxs[n]), leadParts(s2.idxs[n])); ok {
+			idxs := make([]uint16, n+1)
+			copy(idxs, s.idxs[:n])
+			idxs[n] = lead
+			return String{idxs: idxs}, true
+		}
+	}
+	if n == 0 {
+		return String{}, false
+	}
+	return String{idxs: s.idxs[:n]}, true // Reusing the original slice!
+}
+
+// Longest common suffix (as per TrimEnd) of the two strings; false when there is none
+//
+// Mismatching letters still share their common trailing vowel (படி, பாகி => இ).
+func (s String) CommonSuffix(s2 String) (String, bool) {
+	len1, len2 := len(s.idxs), len(s2.idxs)
+	n := 0 // Count of the matching trailing letters
+	for n < len1 && n < len2 && s.idxs[len1-1-n] == s2.idxs[len2-1-n] {
+		n++
+	}
+	if n < len1 && n < len2 {
+		if tail, ok := commonPart(tailParts(s.idxs[len1-1-n]), tailParts(s2.idxs[len2-1-n])); ok {
+			idxs := make([]uint16, n+1)
+			idxs[0] = tail
+			copy(idxs[1:], s.idxs[len1-n:])
+			return String{idxs: idxs}, true
+		}
+	}
+	if n == 0 {
+		return String{}, false
+	}
+	return String{idxs: s.idxs[len1-n:]}, true // Reusing the original slice!
+}
+
+// Splits the letter on its matching leading part (CV => V; Grantha conjunct => its trailing part)
+func splitLead(idx, lead uint16) (rest uint16, ok bool) {
+	if lead0, rest0, ok := base.SplitConjunct(idx); ok && lead0 == lead {
+		return rest0, true
+	}
+	if base.IsCV(idx) {
+		if row, col := base.CVRowCol(idx); base.CIdx(row) == lead {
+			return uint16(col), true
+		}
+	}
+	return idx, false
+}
+
+// Letter itself, followed by its leading parts (longest first); க்ஷா => க்ஷா, க்ஷ், க்
+func leadParts(idx uint16) []uint16 {
+	parts := []uint16{idx}
+	if base.IsCV(idx) {
+		row, _ := base.CVRowCol(idx)
+		parts = append(parts, base.CIdx(row))
+	}
+	if lead, _, ok := base.SplitConjunct(idx); ok && lead != parts[len(parts)-1] {
+		parts = append(parts, lead)
+	}
+	return parts
+}
+
+// Letter itself, followed by its trailing parts (longest first); க்ஷா => க்ஷா, ஷா, ஆ
+func tailParts(idx uint16) []uint16 {
+	parts := []uint16{idx}
+	if _, rest, ok := base.SplitConjunct(idx); ok {
+		parts = append(parts, rest)
+	}
+	if base.IsCV(idx) {
+		_, col := base.CVRowCol(idx)
+		parts = append(parts, uint16(col))
+	}
+	return parts
+}
+
+// First of the given parts, also found among the other parts
+func commonPart(parts, parts2 []uint16) (uint16, bool) {
+	for _, p := range parts {
+		if slices.Contains(parts2, p) {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
 // Simple concatenation!
 func (s String) appendRaw(s2 String) String {
-	idxs := make([]uint8, len(s.idxs)+len(s2.idxs))
+	idxs := make([]uint16, len(s.idxs)+len(s2.idxs))
 	copy(idxs, s.idxs)
 	copy(idxs[len(s.idxs):], s2.idxs)
 	return String{idxs: idxs}
@@ -136,28 +330,56 @@ func (s String) appendRaw(s2 String) String {
 
 // Deep concatenation.
 //
-// Merges any trailing C (on the first) with leading V (on the second), forming CV letter
+// Merges any trailing C (on the first) with leading V (on the second), forming CV letter.
+// Similarly merges trailing க்/ஸ் with leading ஷ-row letter/ரீ, forming Grantha conjunct letter.
 func (s String) Append(a String) String {
+	if len(a.idxs) == 0 { // Trimmed off completely
+		return s
+	}
+	if len(s.idxs) == 0 {
+		return a
+	}
 	lenA := len(s.idxs)
 	letterA := s.LastLetter()
 	letterB := a.FirstLetter()
 	if !letterA.IsC() || !letterB.IsV() {
-		return s.appendRaw(a)
+		if _, ok := base.JoinConjunct(letterA.idx, letterB.idx); !ok {
+			return s.appendRaw(a)
+		}
 	}
-	idxs := make([]uint8, lenA+len(a.idxs)-1)
+	idxs := make([]uint16, lenA, lenA+len(a.idxs))
 	copy(idxs, s.idxs)
-	copy(idxs[lenA-1:], a.idxs)
-	idxs[lenA-1] = letterA.JoinCV(letterB).idx
-	return String{idxs: idxs}
+	idxs = appendJoined(idxs, letterB.idx)
+	return String{idxs: append(idxs, a.idxs[1:]...)}
+}
+
+// Appends the letter, merging it with the trailing letter (C + V => CV; Grantha conjunct)
+//
+// Note: Mutates the trailing letter in the given slice
+func appendJoined(idxs []uint16, idx uint16) []uint16 {
+	last := len(idxs) - 1
+	if last < 0 {
+		return append(idxs, idx)
+	}
+	letterA, letterB := Letter{idx: idxs[last]}, Letter{idx: idx}
+	if letterA.IsC() && letterB.IsV() {
+		// Formed CV may further form Grantha conjunct with its preceding letter (ஸ் + ர் + ஈ => ஸ்ரீ)
+		return appendJoined(idxs[:last], letterA.JoinCV(letterB).idx)
+	}
+	if joined, ok := base.JoinConjunct(letterA.idx, idx); ok {
+		idxs[last] = joined
+		return idxs
+	}
+	return append(idxs, idx)
 }
 
 func (s String) Syllables() []String {
-	// CV and V both may be followed by 0 or more Cs...
+	// CV and V both may be followed by 0 or more Cs (or aytham)...
 	var subs []String
 	sIdx, cIdx := 0, -1
 	for letter := range s.Letters() {
 		cIdx++
-		if letter.IsC() || cIdx == 0 { // Note: cIdx == 0 means the collection hasn't even begun...
+		if letter.IsC() || letter.IsAytham() || cIdx == 0 { // Note: cIdx == 0 means the collection hasn't even begun...
 			continue
 		}
 		// For V and CV
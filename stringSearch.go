@@ -0,0 +1,189 @@
+// Letter-aware search over the Thamizh letter string
+
+package script
+
+import (
+	"iter"
+
+	base "github.com/ThamizhLearner/Thamizh/internal"
+)
+
+// Letter matching mode of the String search operations
+type MatchMode uint8
+
+const (
+	// Whole letters match only; க does not match within கா
+	LetterMatch MatchMode = iota
+
+	// Additionally, the pattern's trailing C matches the consonant half of CV letter (க் within கா);
+	// similarly க்/ஸ் matches the leading half of Grantha conjunct letter
+	PhonemeMatch
+)
+
+// A (non-empty) match of the search pattern
+type strMatch struct {
+	start, end int    // Letter offsets of the match (end exclusive, covering the partially matched letter)
+	partial    bool   // Last letter matched partially (PhonemeMatch)
+	rest       uint16 // Unmatched trailing part of the partially matched letter
+}
+
+// Optional match mode, LetterMatch by default
+func matchMode(modes []MatchMode) MatchMode {
+	if len(modes) == 0 {
+		return LetterMatch
+	}
+	return modes[0]
+}
+
+// Attempts matching the pattern at the given letter offset
+func (s String) matchAt(sub String, i int, mode MatchMode) (strMatch, bool) {
+	n := len(sub.idxs)
+	if n == 0 || i+n > len(s.idxs) {
+		return strMatch{}, false
+	}
+	last := n - 1
+	for j, v := range sub.idxs[:last] {
+		if s.idxs[i+j] != v {
+			return strMatch{}, false
+		}
+	}
+	if s.idxs[i+last] == sub.idxs[last] {
+		return strMatch{start: i, end: i + n}, true
+	}
+	if mode == PhonemeMatch && base.IsC(sub.idxs[last]) {
+		if rest, ok := splitLead(s.idxs[i+last], sub.idxs[last]); ok {
+			return strMatch{start: i, end: i + n, partial: true, rest: rest}, true
+		}
+	}
+	return strMatch{}, false
+}
+
+// Iterator over the non-overlapping matches of the pattern, left to right
+func (s String) matches(sub String, mode MatchMode) iter.Seq[strMatch] {
+	return func(yield func(strMatch) bool) {
+		for i := 0; i < len(s.idxs); {
+			m, ok := s.matchAt(sub, i, mode)
+			if !ok {
+				i++
+				continue
+			}
+			if !yield(m) {
+				return
+			}
+			i = m.end
+		}
+	}
+}
+
+// Letter offset of the first match of the pattern; -1 on none
+//
+// Optional match mode is LetterMatch by default.
+func (s String) Index(sub String, mode ...MatchMode) int {
+	for m := range s.matches(sub, matchMode(mode)) {
+		return m.start
+	}
+	return -1
+}
+
+// Letter offset of the last match of the pattern; -1 on none
+func (s String) LastIndex(sub String, mode ...MatchMode) int {
+	for i := len(s.idxs) - len(sub.idxs); i >= 0; i-- {
+		if _, ok := s.matchAt(sub, i, matchMode(mode)); ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// Indicates the pattern matches within the string
+func (s String) Contains(sub String, mode ...MatchMode) bool { return s.Index(sub, mode...) >= 0 }
+
+// Count of the non-overlapping matches of the pattern
+func (s String) Count(sub String, mode ...MatchMode) int {
+	count := 0
+	for range s.matches(sub, matchMode(mode)) {
+		count++
+	}
+	return count
+}
+
+// Splits the string around the matches of the separator
+//
+// Note: Empty parts are dropped (no zero-length String); the rest of a partially matched letter
+// (PhonemeMatch) leads the following part (கால் split on க் => ஆல்).
+func (s String) Split(sep String, mode ...MatchMode) []String {
+	return s.split(sep, matchMode(mode), false)
+}
+
+// Splits the string after the matches of the separator (the parts retain the separator)
+//
+// Note: Empty parts are dropped (no zero-length String); the rest of a partially matched letter
+// (PhonemeMatch) leads the following part (கால் split after க் => க், ஆல்).
+func (s String) SplitAfter(sep String, mode ...MatchMode) []String {
+	return s.split(sep, matchMode(mode), true)
+}
+
+func (s String) split(sep String, mode MatchMode, after bool) []String {
+	var parts []String
+	var lead []uint16 // Rest of the previous partially matched letter
+	prev := 0
+	for m := range s.matches(sep, mode) {
+		end := m.start
+		if after {
+			end = m.end
+		}
+		if after && m.partial {
+			// Make a new slice, since the partially matched letter is replaced by the separator's
+			idxs := append(append(append([]uint16{}, lead...), s.idxs[prev:end-1]...), sep.LastLetter().idx)
+			parts = append(parts, String{idxs: idxs})
+		} else {
+			parts = appendPart(parts, lead, s.idxs[prev:end])
+		}
+		lead = nil
+		if m.partial {
+			lead = []uint16{m.rest}
+		}
+		prev = m.end
+	}
+	return appendPart(parts, lead, s.idxs[prev:])
+}
+
+// Appends the non-empty part, led by the given letters
+func appendPart(parts []String, lead []uint16, idxs []uint16) []String {
+	if len(lead) == 0 {
+		if len(idxs) == 0 {
+			return parts
+		}
+		return append(parts, String{idxs: idxs}) // Reusing the original slice!
+	}
+	return append(parts, String{idxs: append(append([]uint16{}, lead...), idxs...)})
+}
+
+// Replaces all the non-overlapping matches of the pattern with the given replacement string
+//
+// Replacement joins its surroundings (as per Append); கால் replacing க் with ந் (PhonemeMatch) => நால்
+func (s String) ReplaceAll(old String, new String, mode ...MatchMode) String {
+	var res String
+	var lead []uint16 // Rest of the previous partially matched letter
+	prev, replaced := 0, false
+	for m := range s.matches(old, matchMode(mode)) {
+		res = res.Append(partString(lead, s.idxs[prev:m.start])).Append(new)
+		lead = nil
+		if m.partial {
+			lead = []uint16{m.rest}
+		}
+		prev, replaced = m.end, true
+	}
+	if !replaced {
+		return s
+	}
+	return res.Append(partString(lead, s.idxs[prev:]))
+}
+
+// Part (possibly zero-length, for Append only), led by the given letters
+func partString(lead []uint16, idxs []uint16) String {
+	if len(lead) == 0 {
+		return String{idxs: idxs}
+	}
+	return String{idxs: append(append([]uint16{}, lead...), idxs...)}
+}
@@ -0,0 +1,109 @@
+package script_test // Black box test
+
+import (
+	"slices"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		ustr    string
+		usub    string
+		mode    script.MatchMode
+		index   int
+		lastIdx int
+		count   int
+	}{
+		{"தமிழ்நாடு", "நா", script.LetterMatch, 3, 3, 1},
+		{"கா", "க", script.LetterMatch, -1, -1, 0},
+		{"கா", "க்", script.LetterMatch, -1, -1, 0},
+		{"கா", "க்", script.PhonemeMatch, 0, 0, 1},
+		{"அப்பப்பா", "ப்ப", script.LetterMatch, 1, 1, 1},
+		{"அப்பப்பா", "ப்ப்", script.PhonemeMatch, 1, 3, 2},
+		{"லக்ஷ்மி", "க்", script.PhonemeMatch, 1, 1, 1},
+		{"மரம்மரம்", "மரம்", script.LetterMatch, 0, 3, 2},
+	}
+	for _, tc := range tests {
+		s, sub := script.MustDecode(tc.ustr), script.MustDecode(tc.usub)
+		if got := s.Index(sub, tc.mode); got != tc.index {
+			t.Errorf("Index %s in %s, expected %d, got %d", tc.usub, tc.ustr, tc.index, got)
+		}
+		if got := s.LastIndex(sub, tc.mode); got != tc.lastIdx {
+			t.Errorf("Last index %s in %s, expected %d, got %d", tc.usub, tc.ustr, tc.lastIdx, got)
+		}
+		if got := s.Count(sub, tc.mode); got != tc.count {
+			t.Errorf("Count %s in %s, expected %d, got %d", tc.usub, tc.ustr, tc.count, got)
+		}
+		if got := s.Contains(sub, tc.mode); got != (tc.index >= 0) {
+			t.Errorf("Contains %s in %s, expected %v, got %v", tc.usub, tc.ustr, tc.index >= 0, got)
+		}
+		if tc.index >= 0 && !s.LetterAt(tc.index).Is(sub.FirstLetter()) && tc.mode == script.LetterMatch {
+			t.Errorf("Index %s in %s, letter at %d is %s", tc.usub, tc.ustr, tc.index, s.LetterAt(tc.index))
+		}
+	}
+
+	s := script.MustDecode("கா")
+	if s.Index(script.MustDecode("க்")) != -1 {
+		t.Errorf("Index expected letter match by default")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		ustr   string
+		usep   string
+		mode   script.MatchMode
+		parts  []string
+		aparts []string
+	}{
+		{"மாவும்பலாவும்வாழையும்", "உம்", script.LetterMatch, []string{"மாவும்பலாவும்வாழையும்"}, []string{"மாவும்பலாவும்வாழையும்"}},
+		{"மாவும்பலாவும்வாழையும்", "வும்", script.LetterMatch, []string{"மா", "பலா", "வாழையும்"}, []string{"மாவும்", "பலாவும்", "வாழையும்"}},
+		{"அவன்தான்அவன்", "அவன்", script.LetterMatch, []string{"தான்"}, []string{"அவன்", "தான்அவன்"}},
+		{"கால்கோள்", "க்", script.PhonemeMatch, []string{"ஆல்", "ஓள்"}, []string{"க்", "ஆல்க்", "ஓள்"}},
+	}
+	for _, tc := range tests {
+		s, sep := script.MustDecode(tc.ustr), script.MustDecode(tc.usep)
+		if got := partUStrs(s.Split(sep, tc.mode)); !slices.Equal(got, tc.parts) {
+			t.Errorf("Split %s on %s, expected %v, got %v", tc.ustr, tc.usep, tc.parts, got)
+		}
+		if got := partUStrs(s.SplitAfter(sep, tc.mode)); !slices.Equal(got, tc.aparts) {
+			t.Errorf("Split %s after %s, expected %v, got %v", tc.ustr, tc.usep, tc.aparts, got)
+		}
+	}
+}
+
+func partUStrs(parts []script.String) []string {
+	var ustrs []string
+	for _, p := range parts {
+		ustrs = append(ustrs, p.String())
+	}
+	return ustrs
+}
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		ustr string
+		uold string
+		unew string
+		mode script.MatchMode
+		ures string
+	}{
+		{"மரம்மரம்", "மரம்", "செடி", script.LetterMatch, "செடிசெடி"},
+		{"கால்", "க்", "ந்", script.LetterMatch, "கால்"},
+		{"கால்", "க்", "ந்", script.PhonemeMatch, "நால்"},
+		{"பள்ளிப்பள்ளி", "ள்", "ல்", script.PhonemeMatch, "பல்லிப்பல்லி"},
+		{"தமிழ்", "ழ்", "இழ்", script.LetterMatch, "தமிஇழ்"},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		got := s.ReplaceAll(script.MustDecode(tc.uold), script.MustDecode(tc.unew), tc.mode)
+		if got.String() != tc.ures {
+			t.Errorf("Replace all %s => %s in %s, expected %s, got %s", tc.uold, tc.unew, tc.ustr, tc.ures, got)
+		}
+		if s.String() != tc.ustr {
+			t.Errorf("Replace all mutated the original string %s, got %s", tc.ustr, s)
+		}
+	}
+}
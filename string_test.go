@@ -1,6 +1,8 @@
 package script_test // Black box test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	script "github.com/ThamizhLearner/Thamizh"
@@ -11,6 +13,8 @@ func TestDecode(t *testing.T) {
 		"அ", "க", "ழ்",
 		"தமிழ்",
 		"ஒட்டுமொத்தமாகப்பார்த்துக்கொண்டிருந்தாள்",
+		"ஜனவரி", "ஸ்ரீ", "லக்ஷ்மி", "ஹோஷியார்", "ஶ்ரேயா", "க்ஷேத்திரம்",
+		"அஃது", "எஃகு", "கஃசு",
 	}
 	for _, ustr := range ustrs {
 		s := script.MustDecode(ustr)
@@ -20,6 +24,30 @@ func TestDecode(t *testing.T) {
 	}
 }
 
+func TestDecodeGranthaConjunct(t *testing.T) {
+	tests := []struct {
+		ustr    string
+		letters []string
+	}{
+		{"ஸ்ரீ", []string{"ஸ்ரீ"}},
+		{"ஸ்ரா", []string{"ஸ்", "ரா"}},
+		{"லக்ஷ்மி", []string{"ல", "க்ஷ்", "மி"}},
+		{"கஷா", []string{"க", "ஷா"}},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		if s.Len() != len(tc.letters) {
+			t.Errorf("Decode %s, expected %d letters, got %d", tc.ustr, len(tc.letters), s.Len())
+			continue
+		}
+		for i, l := range tc.letters {
+			if s.LetterAt(i).String() != l {
+				t.Errorf("Decode %s, letter at %d, expected %s, got %s", tc.ustr, i, l, s.LetterAt(i))
+			}
+		}
+	}
+}
+
 func TestLetterAt(t *testing.T) {
 	s := script.MustDecode("தமிழ்")
 	if s.FirstLetter().String() != "த" {
@@ -54,6 +82,9 @@ func TestTrimEnd(t *testing.T) {
 		ures  string
 	}{
 		{"தமிழிலக்கனம்", "இலக்கனம்", "தமிழ்"},
+		{"ராஜா", "ஆ", "ராஜ்"},
+		{"லக்ஷ்மி", "ஷ்மி", "லக்"},
+		{"ஸ்ரீ", "ரீ", "ஸ்"},
 	}
 	for i, tc := range tests {
 		s := script.MustDecode(tc.ustr)
@@ -65,6 +96,120 @@ func TestTrimEnd(t *testing.T) {
 	}
 }
 
+func TestTrimStart(t *testing.T) {
+	tests := []struct {
+		ustr  string
+		utrim string
+		ures  string
+	}{
+		{"தமிழிலக்கனம்", "தமிழ்", "இலக்கனம்"},
+		{"ராஜா", "ராஜ்", "ஆ"},
+		{"கா", "க்", "ஆ"},
+		{"லக்ஷ்மி", "லக்", "ஷ்மி"},
+		{"ஸ்ரீ", "ஸ்", "ரீ"},
+		{"க்ஷேமம்", "க்ஷ்", "ஏமம்"},
+	}
+	for i, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		trimStr := script.MustDecode(tc.utrim)
+		resStr, ok := s.TrimStart(trimStr)
+		if !ok || resStr.String() != tc.ures {
+			t.Errorf("Trim start %d, expected %s, got %s", i, tc.ures, resStr)
+		}
+		if !s.HasPrefix(trimStr) {
+			t.Errorf("Has prefix %d, expected %s to start with %s", i, tc.ustr, tc.utrim)
+		}
+	}
+
+	for _, utrim := range []string{"கி", "க்க", "தமிழ்ழ்", "ம"} {
+		s := script.MustDecode("கா")
+		if resStr, ok := s.TrimStart(script.MustDecode(utrim)); ok || resStr.String() != "கா" {
+			t.Errorf("Trim start %s, expected no match, got %s", utrim, resStr)
+		}
+	}
+}
+
+func TestReplaceStart(t *testing.T) {
+	tests := []struct {
+		ustr     string
+		utrim    string
+		uprepend string
+		ures     string
+	}{
+		{"கால்", "க்", "ந்", "நால்"},
+		{"தமிழ்நாடு", "தமிழ்", "கேரள", "கேரளநாடு"},
+		{"அவன்", "அவன்", "இவன்", "இவன்"},
+	}
+	for i, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		resStr, ok := s.ReplaceStart(script.MustDecode(tc.utrim), script.MustDecode(tc.uprepend))
+		if !ok || resStr.String() != tc.ures {
+			t.Errorf("Replace start %d, expected %s, got %s", i, tc.ures, resStr)
+		}
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	s := script.MustDecode("ராஜா")
+	for _, usuffix := range []string{"ஆ", "ஜா", "ராஜா"} {
+		if !s.HasSuffix(script.MustDecode(usuffix)) {
+			t.Errorf("Has suffix, expected %s to end with %s", s, usuffix)
+		}
+	}
+	for _, usuffix := range []string{"இ", "ஜ்", "ராஜாஜா"} {
+		if s.HasSuffix(script.MustDecode(usuffix)) {
+			t.Errorf("Has suffix, expected %s not to end with %s", s, usuffix)
+		}
+	}
+}
+
+func TestEndsWithKutriyalukaram(t *testing.T) {
+	tests := []struct {
+		ustr string
+		want bool
+	}{
+		{"நாடு", true}, {"உலகு", true}, {"பிறப்பு", true}, {"காற்று", true},
+		{"பசு", false}, {"அறிவு", false}, {"கடல்", false}, {"மணி", false},
+	}
+	for _, tc := range tests {
+		if got := script.MustDecode(tc.ustr).EndsWithKutriyalukaram(); got != tc.want {
+			t.Errorf("EndsWithKutriyalukaram(%s), expected %v, got %v", tc.ustr, tc.want, got)
+		}
+	}
+}
+
+func TestCommonPrefixSuffix(t *testing.T) {
+	tests := []struct {
+		ustr1, ustr2 string
+		uprefix      string
+		usuffix      string
+	}{
+		{"தமிழ்நாடு", "தமிழ்மொழி", "தமிழ்", ""},
+		{"படி", "பாகி", "ப்", "இ"},
+		{"மரம்", "மரம்", "மரம்", "மரம்"},
+		{"க்ஷேமம்", "கமலம்", "க்", "அம்"},
+		{"லக்ஷ்மி", "ஷ்மி", "", "ஷ்மி"},
+		{"அம்மா", "இம்மா", "", "ம்மா"},
+	}
+	for _, tc := range tests {
+		s1, s2 := script.MustDecode(tc.ustr1), script.MustDecode(tc.ustr2)
+		prefix, ok := s1.CommonPrefix(s2)
+		if ok != (tc.uprefix != "") || ok && prefix.String() != tc.uprefix {
+			t.Errorf("Common prefix %s %s, expected %s, got %s", tc.ustr1, tc.ustr2, tc.uprefix, prefix)
+		}
+		if ok && (!s1.HasPrefix(prefix) || !s2.HasPrefix(prefix)) {
+			t.Errorf("Common prefix %s %s, got %s not a prefix of both", tc.ustr1, tc.ustr2, prefix)
+		}
+		suffix, ok := s1.CommonSuffix(s2)
+		if ok != (tc.usuffix != "") || ok && suffix.String() != tc.usuffix {
+			t.Errorf("Common suffix %s %s, expected %s, got %s", tc.ustr1, tc.ustr2, tc.usuffix, suffix)
+		}
+		if ok && (!s1.HasSuffix(suffix) || !s2.HasSuffix(suffix)) {
+			t.Errorf("Common suffix %s %s, got %s not a suffix of both", tc.ustr1, tc.ustr2, suffix)
+		}
+	}
+}
+
 func TestAppend(t *testing.T) {
 	tests := []struct {
 		ustr    string
@@ -72,6 +217,10 @@ func TestAppend(t *testing.T) {
 		ures    string
 	}{
 		{"தமிழ்", "இலக்கனம்", "தமிழிலக்கனம்"},
+		{"ராஜ்", "ஆ", "ராஜா"},
+		{"லக்", "ஷ்மி", "லக்ஷ்மி"},
+		{"ஸ்", "ரீ", "ஸ்ரீ"},
+		{"ஸ்ர்", "ஈ", "ஸ்ரீ"},
 	}
 	for i, tc := range tests {
 		s := script.MustDecode(tc.ustr)
@@ -92,6 +241,8 @@ func TestSyllabification(t *testing.T) {
 		{"ழ்", "ழ்"},
 		{"க", "க"},
 		{"தமிழ்", "த-மிழ்"},
+		{"அஃது", "அஃ-து"},
+		{"எஃகு", "எஃ-கு"},
 		{"ஒட்டுமொத்தமாகப்பார்த்துக்கொண்டிருந்தாள்", "ஒட்-டு-மொத்-த-மா-கப்-பார்த்-துக்-கொண்-டி-ருந்-தாள்"},
 	}
 	for _, testCase := range tests {
@@ -101,3 +252,73 @@ func TestSyllabification(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeErr(t *testing.T) {
+	tests := []struct {
+		ustr       string
+		byteOffset int
+		runeOffset int
+		r          rune
+		reason     script.DecodeErrReason
+	}{
+		{"தமிழ் மொழி", 15, 5, ' ', script.ErrUnsupportedCode},
+		{"ாக", 0, 0, 'ா', script.ErrVowelSignWithoutBaseConsonant},
+		{"க்ா", 6, 2, 'ா', script.ErrVowelSignWithoutBaseConsonant},
+		{"கா்", 6, 2, '்', script.ErrDotAfterVowel},
+		{"அ்", 3, 1, '்', script.ErrDotAfterVowel},
+		{"க்்", 6, 2, '்', script.ErrDotWithoutBaseConsonant},
+		{"கிு", 6, 2, 'ு', script.ErrDoubleVowelSign},
+		{"", 0, 0, 0, script.ErrEmptyString},
+	}
+	for _, tc := range tests {
+		_, err := script.DecodeErr(tc.ustr)
+		var decErr *script.DecodeError
+		if !errors.As(err, &decErr) {
+			t.Errorf("Decode error %q, expected DecodeError, got %v", tc.ustr, err)
+			continue
+		}
+		if decErr.ByteOffset != tc.byteOffset || decErr.RuneOffset != tc.runeOffset || decErr.Rune != tc.r || decErr.Reason != tc.reason {
+			t.Errorf("Decode error %q, expected {%d %d %U %v}, got %+v", tc.ustr, tc.byteOffset, tc.runeOffset, tc.r, tc.reason, *decErr)
+		}
+	}
+
+	s, err := script.DecodeErr("தமிழ்")
+	if err != nil || s.String() != "தமிழ்" {
+		t.Errorf("Decode error on valid string: %v", err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		nfc string
+		nfd string
+	}{
+		{"பொன்", "ப\u0BC6\u0BBEன்"},
+		{"கோலம்", "க\u0BC7\u0BBEலம்"},
+		{"பௌவத்", "ப\u0BC6\u0BD7வத்"},
+		{"ஔவை", "\u0B92\u0BD7வை"},
+		{"க்ஷோ test", "க்ஷ\u0BC7\u0BBE test"},
+	}
+	for _, tc := range tests {
+		if got := script.Normalize(tc.nfd, script.NFC); got != tc.nfc {
+			t.Errorf("Normalize NFC %q, expected %q, got %q", tc.nfd, tc.nfc, got)
+		}
+		if got := script.Normalize(tc.nfc, script.NFD); got != tc.nfd {
+			t.Errorf("Normalize NFD %q, expected %q, got %q", tc.nfc, tc.nfd, got)
+		}
+		s, ok := script.Decode(strings.TrimSuffix(tc.nfd, " test"))
+		if !ok || s.String() != strings.TrimSuffix(tc.nfc, " test") {
+			t.Errorf("Decode decomposed %q, got %s", tc.nfd, s)
+		}
+	}
+
+	_, err := script.DecodeErr("கௗ")
+	var decErr *script.DecodeError
+	if !errors.As(err, &decErr) || decErr.Reason != script.ErrStrayLengthMark || decErr.ByteOffset != 3 {
+		t.Errorf("Stray length mark, got %v", err)
+	}
+	_, err = script.DecodeErr("கொா")
+	if !errors.As(err, &decErr) || decErr.Reason != script.ErrDoubleVowelSign {
+		t.Errorf("Double vowel sign after composed sign, got %v", err)
+	}
+}
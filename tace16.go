@@ -0,0 +1,108 @@
+// TACE16 (Tamil All Character Encoding) codec
+
+package script
+
+import (
+	"encoding/binary"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ThamizhLearner/Thamizh/internal/tace16"
+)
+
+const tace16Name = "TACE16"
+
+// Encodes the given string as TACE16 code sequence (one code per letter)
+//
+// Note: ஸ்ரீ is encoded as ஸ் + ரீ; ஶ based letters have no TACE16 code (EncodeError)
+func EncodeTACE16(s String) ([]uint16, error) {
+	codes := make([]uint16, 0, len(s.idxs))
+	for i, idx := range s.idxs {
+		var ok bool
+		if codes, ok = tace16.AppendCodes(codes, idx); !ok {
+			return nil, &EncodeError{Encoding: tace16Name, LetterOffset: i, Letter: Letter{idx: idx}}
+		}
+	}
+	return codes, nil
+}
+
+// Encodes the given string as UTF-8 encoded TACE16 text
+func EncodeTACE16UTF8(s String) (string, error) {
+	codes, err := EncodeTACE16(s)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, code := range codes {
+		sb.WriteRune(rune(code))
+	}
+	return sb.String(), nil
+}
+
+// Encodes the given string as UTF-16 encoded TACE16 byte stream (of the given byte order)
+//
+// Note: TACE16 codes being within the Basic Multilingual Plane, each code is a single UTF-16 code unit
+func EncodeTACE16UTF16(s String, order binary.ByteOrder) ([]byte, error) {
+	codes, err := EncodeTACE16(s)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 2*len(codes))
+	for i, code := range codes {
+		order.PutUint16(b[2*i:], code)
+	}
+	return b, nil
+}
+
+// Decodes the given TACE16 code sequence
+//
+// On failure, returns *DecodeError locating the offending code (ByteOffset counts 2 bytes per code)
+func DecodeTACE16(codes []uint16) (String, error) {
+	var idxs []uint16
+	for i, code := range codes {
+		var ok bool
+		if idxs, ok = tace16.AppendLetter(idxs, code); !ok {
+			return String{}, &DecodeError{ByteOffset: 2 * i, RuneOffset: i, Rune: rune(code), Reason: ErrUnsupportedCode}
+		}
+	}
+	if idxs == nil {
+		return String{}, &DecodeError{Reason: ErrEmptyString}
+	}
+	return String{idxs: idxs}, nil
+}
+
+// Decodes the given UTF-8 encoded TACE16 text
+//
+// On failure, returns *DecodeError locating the offending code point
+func DecodeTACE16UTF8(text string) (String, error) {
+	var idxs []uint16
+	runeOffset := -1
+	for byteOffset, r := range text {
+		runeOffset++
+		var ok bool
+		if r <= 0xFFFF {
+			idxs, ok = tace16.AppendLetter(idxs, uint16(r))
+		}
+		if !ok {
+			return String{}, &DecodeError{ByteOffset: byteOffset, RuneOffset: runeOffset, Rune: r, Reason: ErrUnsupportedCode}
+		}
+	}
+	if idxs == nil {
+		return String{}, &DecodeError{Reason: ErrEmptyString}
+	}
+	return String{idxs: idxs}, nil
+}
+
+// Decodes the given UTF-16 encoded TACE16 byte stream (of the given byte order)
+//
+// On failure, returns *DecodeError locating the offending code unit (or the trailing odd byte)
+func DecodeTACE16UTF16(b []byte, order binary.ByteOrder) (String, error) {
+	codes := make([]uint16, len(b)/2)
+	for i := range codes {
+		codes[i] = order.Uint16(b[2*i:])
+	}
+	if len(b)%2 != 0 {
+		return String{}, &DecodeError{ByteOffset: len(b) - 1, RuneOffset: len(codes), Rune: utf8.RuneError, Reason: ErrUnsupportedCode}
+	}
+	return DecodeTACE16(codes)
+}
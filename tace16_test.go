@@ -0,0 +1,82 @@
+package script_test // Black box test
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+// All 246 native letters (and aytham) as Unicode letter strings
+func nativeLetterUStrs() []string {
+	vowels := []string{"அ", "ஆ", "இ", "ஈ", "உ", "ஊ", "எ", "ஏ", "ஐ", "ஒ", "ஓ", "ஔ"}
+	signs := []string{"", "ா", "ி", "ீ", "ு", "ூ", "ெ", "ே", "ை", "ொ", "ோ", "ௌ"}
+	consonants := []string{"க", "ங", "ச", "ஞ", "ட", "ண", "த", "ந", "ப", "ம", "ய", "ர", "ல", "வ", "ழ", "ள", "ற", "ன"}
+	ustrs := append([]string{"ஃ"}, vowels...)
+	for _, c := range consonants {
+		ustrs = append(ustrs, c+"்")
+		for _, v := range signs {
+			ustrs = append(ustrs, c+v)
+		}
+	}
+	return ustrs
+}
+
+func TestTACE16RoundTrip(t *testing.T) {
+	ustrs := append(nativeLetterUStrs(), "ஜ", "ஷ்", "ஸா", "ஹௌ", "க்ஷ", "ஸ்ரீ", "லக்ஷ்மி")
+	if len(ustrs) != 247+7 {
+		t.Fatalf("Expected 247 native letters, got %d", len(ustrs)-7)
+	}
+	seen := map[uint16]string{}
+	for _, ustr := range ustrs {
+		s := script.MustDecode(ustr)
+		codes, err := script.EncodeTACE16(s)
+		if err != nil {
+			t.Errorf("TACE16 encode %s: %v", ustr, err)
+			continue
+		}
+		if s.Len() == 1 && len(codes) == 1 {
+			if prev, ok := seen[codes[0]]; ok {
+				t.Errorf("TACE16 code %04X shared by %s and %s", codes[0], prev, ustr)
+			}
+			seen[codes[0]] = ustr
+		}
+		got, err := script.DecodeTACE16(codes)
+		if err != nil || got.String() != ustr {
+			t.Errorf("TACE16 round trip %s, got %s (%v)", ustr, got, err)
+		}
+
+		text, _ := script.EncodeTACE16UTF8(s)
+		if got, err := script.DecodeTACE16UTF8(text); err != nil || got.String() != ustr {
+			t.Errorf("TACE16 UTF-8 round trip %s, got %s (%v)", ustr, got, err)
+		}
+		b, _ := script.EncodeTACE16UTF16(s, binary.BigEndian)
+		if got, err := script.DecodeTACE16UTF16(b, binary.BigEndian); err != nil || got.String() != ustr {
+			t.Errorf("TACE16 UTF-16 round trip %s, got %s (%v)", ustr, got, err)
+		}
+	}
+
+	codes, _ := script.EncodeTACE16(script.MustDecode("அக்க"))
+	if len(codes) != 3 || codes[0] != 0xE181 || codes[1] != 0xE190 || codes[2] != 0xE191 {
+		t.Errorf("TACE16 codes, got %X", codes)
+	}
+}
+
+func TestTACE16Errors(t *testing.T) {
+	_, err := script.EncodeTACE16(script.MustDecode("அஶ"))
+	var encErr *script.EncodeError
+	if !errors.As(err, &encErr) || encErr.LetterOffset != 1 {
+		t.Errorf("TACE16 encode error, got %v", err)
+	}
+
+	_, err = script.DecodeTACE16UTF8("\uE181 ")
+	var decErr *script.DecodeError
+	if !errors.As(err, &decErr) || decErr.ByteOffset != 3 || decErr.RuneOffset != 1 || decErr.Reason != script.ErrUnsupportedCode {
+		t.Errorf("TACE16 decode error, got %v", err)
+	}
+	_, err = script.DecodeTACE16UTF16([]byte{0xE1, 0x81, 0xE1}, binary.BigEndian)
+	if !errors.As(err, &decErr) || decErr.ByteOffset != 2 {
+		t.Errorf("TACE16 UTF-16 odd length error, got %v", err)
+	}
+}
@@ -0,0 +1,249 @@
+// Tanglish (phonetic Latin) input method
+
+package script
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tanglish conversion candidate
+type TanglishCandidate struct {
+	Str   String
+	Score float64 // Plausibility score (0 - 1]; product of the chosen alternative weights
+}
+
+// Thamizh alternative for a Tanglish unit
+type tanglishAlt struct {
+	ustr   string
+	weight float64
+}
+
+// Tanglish token kind
+type tanglishKind uint8
+
+const (
+	tVowel tanglishKind = iota
+	tConsonant
+)
+
+// Tanglish token; alts nil for the context dependent tokens (see tanglishAlts)
+type tanglishToken struct {
+	token string
+	kind  tanglishKind
+	alts  []tanglishAlt
+}
+
+// Tanglish unit (tokenized input)
+type tanglishUnit struct {
+	*tanglishToken
+	offset int // Byte offset within the input
+}
+
+func alt(ustr string) []tanglishAlt { return []tanglishAlt{{ustr, 1}} }
+
+// Tanglish tokens (longest tokens first, case sensitive)
+var tanglishTokens = func() []*tanglishToken {
+	tokens := []*tanglishToken{
+		{"aa", tVowel, alt("ஆ")}, {"A", tVowel, alt("ஆ")}, {"ai", tVowel, alt("ஐ")},
+		{"au", tVowel, alt("ஔ")}, {"ow", tVowel, alt("ஔ")}, {"a", tVowel, alt("அ")},
+		{"ii", tVowel, alt("ஈ")}, {"ee", tVowel, alt("ஈ")}, {"I", tVowel, alt("ஈ")}, {"i", tVowel, alt("இ")},
+		{"uu", tVowel, alt("ஊ")}, {"oo", tVowel, alt("ஊ")}, {"U", tVowel, alt("ஊ")}, {"u", tVowel, alt("உ")},
+		{"E", tVowel, alt("ஏ")}, {"e", tVowel, alt("எ")}, {"O", tVowel, alt("ஓ")}, {"o", tVowel, alt("ஒ")},
+
+		{"shri", tConsonant, alt("ஸ்ரீ")}, {"sri", tConsonant, alt("ஸ்ரீ")},
+		{"ksh", tConsonant, alt("க்ஷ்")}, {"x", tConsonant, alt("க்ஷ்")},
+		{"ng", tConsonant, nil}, {"nj", tConsonant, alt("ஞ்")}, {"gn", tConsonant, alt("ஞ்")},
+		{"zh", tConsonant, alt("ழ்")}, {"sh", tConsonant, alt("ஷ்")}, {"ch", tConsonant, alt("ச்")},
+		{"th", tConsonant, alt("த்")}, {"dh", tConsonant, alt("த்")}, {"tr", tConsonant, nil}, {"dr", tConsonant, nil},
+		{"ph", tConsonant, alt("ஃப்")}, {"f", tConsonant, alt("ஃப்")}, {"bh", tConsonant, alt("ப்")},
+		{"kh", tConsonant, alt("க்")}, {"gh", tConsonant, alt("க்")},
+		{"k", tConsonant, alt("க்")}, {"g", tConsonant, alt("க்")}, {"c", tConsonant, alt("க்")}, {"q", tConsonant, alt("க்")},
+		{"j", tConsonant, alt("ஜ்")}, {"s", tConsonant, nil}, {"z", tConsonant, alt("ஸ்")}, {"h", tConsonant, alt("ஹ்")},
+		{"t", tConsonant, nil}, {"d", tConsonant, nil}, {"T", tConsonant, alt("ட்")}, {"D", tConsonant, alt("ட்")},
+		{"n", tConsonant, nil}, {"N", tConsonant, alt("ண்")},
+		{"p", tConsonant, alt("ப்")}, {"b", tConsonant, alt("ப்")}, {"m", tConsonant, alt("ம்")}, {"y", tConsonant, alt("ய்")},
+		{"rr", tConsonant, alt("ற்ற்")}, {"r", tConsonant, nil}, {"R", tConsonant, alt("ற்")},
+		{"l", tConsonant, nil}, {"L", tConsonant, alt("ள்")}, {"v", tConsonant, alt("வ்")}, {"w", tConsonant, alt("வ்")},
+	}
+	slices.SortStableFunc(tokens, func(a, b *tanglishToken) int { return len(b.token) - len(a.token) })
+	return tokens
+}()
+
+// Tokenizes the given Tanglish word
+//
+// Upper case letters without token of their own are taken as lower case.
+func tanglishUnits(text string) ([]tanglishUnit, error) {
+	var units []tanglishUnit
+	for i, runeOffset := 0, 0; i < len(text); runeOffset++ {
+		var match *tanglishToken
+		for _, t := range tanglishTokens {
+			if strings.HasPrefix(text[i:], t.token) {
+				match = t
+				break
+			}
+		}
+		if match == nil {
+			lower := strings.ToLower(text[i : i+1])
+			for _, t := range tanglishTokens {
+				if t.token == lower {
+					match = t
+					break
+				}
+			}
+		}
+		if match == nil {
+			r, _ := utf8.DecodeRuneInString(text[i:])
+			return nil, &DecodeError{ByteOffset: i, RuneOffset: runeOffset, Rune: r, Reason: ErrUnsupportedCode}
+		}
+		units = append(units, tanglishUnit{match, i})
+		i += len(match.token)
+		runeOffset += len(match.token) - 1 // Note: Tokens are ASCII
+	}
+	if units == nil {
+		return nil, &DecodeError{Reason: ErrEmptyString}
+	}
+	return units, nil
+}
+
+// Gets the (position-aware) Thamizh alternatives for the unit at given position, most plausible first
+func tanglishAlts(units []tanglishUnit, i int) []tanglishAlt {
+	u := units[i]
+	if u.alts != nil {
+		return u.alts
+	}
+	initial := i == 0
+	var prev, next string
+	if !initial {
+		prev = units[i-1].token
+	}
+	nextIsVowel := false
+	if i+1 < len(units) {
+		next, nextIsVowel = units[i+1].token, units[i+1].kind == tVowel
+	}
+	switch u.token {
+	case "n":
+		switch {
+		case initial:
+			return []tanglishAlt{{"ந்", 0.9}, {"ன்", 0.05}, {"ண்", 0.05}}
+		case next == "dr":
+			return []tanglishAlt{{"ன்", 0.8}, {"ண்", 0.1}, {"ந்", 0.1}}
+		case next == "th" || next == "dh":
+			return []tanglishAlt{{"ந்", 0.9}, {"ன்", 0.1}}
+		case next == "t" || next == "d" || next == "T" || next == "D":
+			return []tanglishAlt{{"ண்", 0.8}, {"ன்", 0.2}}
+		case next == "k" || next == "g":
+			return []tanglishAlt{{"ங்", 0.8}, {"ன்", 0.2}}
+		default:
+			return []tanglishAlt{{"ன்", 0.7}, {"ண்", 0.2}, {"ந்", 0.1}}
+		}
+	case "ng":
+		if nextIsVowel {
+			return []tanglishAlt{{"ங்க்", 0.7}, {"ங்", 0.3}}
+		}
+		return alt("ங்")
+	case "t", "d":
+		if initial {
+			return []tanglishAlt{{"த்", 0.9}, {"ட்", 0.1}}
+		}
+		return []tanglishAlt{{"ட்", 0.7}, {"த்", 0.3}}
+	case "tr":
+		if initial {
+			return []tanglishAlt{{"ட்ர்", 0.5}, {"த்ர்", 0.5}}
+		}
+		return []tanglishAlt{{"ற்ற்", 0.7}, {"ட்ர்", 0.15}, {"த்ர்", 0.15}}
+	case "dr":
+		if prev == "n" {
+			return []tanglishAlt{{"ற்", 0.8}, {"ட்ர்", 0.1}, {"த்ர்", 0.1}} // ன்ற
+		}
+		return []tanglishAlt{{"ட்ர்", 0.5}, {"த்ர்", 0.5}}
+	case "s":
+		if initial {
+			return []tanglishAlt{{"ச்", 0.6}, {"ஸ்", 0.4}}
+		}
+		return []tanglishAlt{{"ச்", 0.5}, {"ஸ்", 0.5}}
+	case "r":
+		if initial {
+			return []tanglishAlt{{"ர்", 0.9}, {"ற்", 0.1}}
+		}
+		return []tanglishAlt{{"ர்", 0.7}, {"ற்", 0.3}}
+	case "l":
+		return []tanglishAlt{{"ல்", 0.6}, {"ள்", 0.3}, {"ழ்", 0.1}}
+	default:
+		panic("Unexpected context dependent Tanglish token")
+	}
+}
+
+// Beam width of the candidate search
+const tanglishBeam = 32
+
+// Common words spelled with the less plausible alternatives (ண for medial n, ள for l ...); rank first among the candidates
+var tanglishLexicon = map[string]bool{
+	"வணக்கம்": true, "கணக்கு": true, "பணம்": true, "மணி": true, "உணவு": true,
+	"நண்பன்": true, "அண்ணன்": true, "தண்ணீர்": true, "கண்": true, "பெண்": true, "எண்": true,
+	"வாழ்க": true, "வாழ்க்கை": true, "மழை": true, "பழம்": true, "கிழமை": true, "தமிழ்": true,
+	"வேலை": true, "நாள்": true, "பள்ளி": true, "வெள்ளை": true, "இல்லை": true,
+}
+
+// Converts the given Tanglish word into ranked (most plausible first) Thamizh string candidates
+//
+// Ambiguous units (n => ந/ன/ண, l => ல/ள/ழ, r => ர/ற, t => ட/த, s => ச/ஸ) are resolved by position-aware rules.
+// Upper case N L R T D force ண ள ற ட ட; zh => ழ, rr/RR => ற்ற, ndr => ன்ற. Common words (e.g. வணக்கம் for
+// vanakkam) rank ahead of the other candidates. Returns at most max candidates; none for a max of zero or less.
+// On failure, returns *DecodeError locating the offending character.
+func TanglishCandidates(text string, max int) ([]TanglishCandidate, error) {
+	units, err := tanglishUnits(text)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []TanglishCandidate{{Score: 1}}
+	for i := range units {
+		var expanded []TanglishCandidate
+		for _, c := range candidates {
+			for _, a := range tanglishAlts(units, i) {
+				s := MustDecode(a.ustr)
+				if c.Str.idxs != nil {
+					s = c.Str.Append(s) // C + V => CV
+				}
+				expanded = append(expanded, TanglishCandidate{Str: s, Score: c.Score * a.weight})
+			}
+		}
+		slices.SortStableFunc(expanded, func(a, b TanglishCandidate) int {
+			switch {
+			case a.Score > b.Score:
+				return -1
+			case a.Score < b.Score:
+				return 1
+			default:
+				return 0
+			}
+		})
+		candidates = expanded[:min(len(expanded), tanglishBeam)]
+	}
+	slices.SortStableFunc(candidates, func(a, b TanglishCandidate) int {
+		switch inA, inB := tanglishLexicon[a.Str.String()], tanglishLexicon[b.Str.String()]; {
+		case inA && !inB:
+			return -1
+		case inB && !inA:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if max < 0 {
+		max = 0
+	}
+	return candidates[:min(len(candidates), max)], nil
+}
+
+// Converts the given Tanglish word into the most plausible Thamizh string
+//
+// E.g. thamizh => தமிழ், kaaRRu => காற்று
+func ParseTanglish(text string) (String, error) {
+	candidates, err := TanglishCandidates(text, 1)
+	if err != nil {
+		return String{}, err
+	}
+	return candidates[0].Str, nil
+}
@@ -0,0 +1,59 @@
+package script_test // Black box test
+
+import (
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestParseTanglish(t *testing.T) {
+	tests := []struct {
+		tanglish string
+		ustr     string
+	}{
+		{"thamizh", "தமிழ்"},
+		{"kaaRRu", "காற்று"},
+		{"kaatru", "காற்று"},
+		{"naan", "நான்"},
+		{"nandri", "நன்றி"},
+		{"vaNakkam", "வணக்கம்"},
+		{"vanakkam", "வணக்கம்"}, // Lexicon word
+		{"manam", "மனம்"},       // Not in the lexicon (மணம் as plausible)
+		{"pandhu", "பந்து"},
+		{"padam", "படம்"},
+		{"sangam", "சங்கம்"},
+		{"paLLi", "பள்ளி"},
+		{"kai", "கை"},
+		{"Eri", "ஏரி"},
+		{"srinivaasan", "ஸ்ரீனிவாசன்"},
+	}
+	for _, tc := range tests {
+		got, err := script.ParseTanglish(tc.tanglish)
+		if err != nil || got.String() != tc.ustr {
+			t.Errorf("Tanglish %s, expected %s, got %s (%v)", tc.tanglish, tc.ustr, got, err)
+		}
+	}
+	if _, err := script.ParseTanglish("tamil nadu"); err == nil {
+		t.Errorf("Tanglish error expected")
+	}
+}
+
+func TestTanglishCandidates(t *testing.T) {
+	candidates, err := script.TanglishCandidates("vanakkam", 5)
+	if err != nil || len(candidates) != 3 {
+		t.Fatalf("Tanglish candidates, got %d (%v)", len(candidates), err)
+	}
+	if got := candidates[0].Str.String(); got != "வணக்கம்" {
+		t.Errorf("Tanglish candidates vanakkam, expected வணக்கம் first (lexicon word), got %s", got)
+	}
+	for i := 2; i < len(candidates); i++ {
+		if candidates[i].Score > candidates[i-1].Score {
+			t.Errorf("Tanglish candidates not ranked")
+		}
+	}
+	for _, max := range []int{0, -1} {
+		if candidates, err := script.TanglishCandidates("vanakkam", max); err != nil || len(candidates) != 0 {
+			t.Errorf("Tanglish candidates (max %d), expected none, got %d (%v)", max, len(candidates), err)
+		}
+	}
+}
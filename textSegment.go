@@ -0,0 +1,33 @@
+// Mixed-script text segmentation
+
+package script
+
+import (
+	"iter"
+
+	"github.com/ThamizhLearner/Thamizh/internal/unicode"
+)
+
+// Segment of mixed-script text; either Thamizh segment or opaque (non-Thamizh) segment
+type TextSegment struct {
+	Start, End int    // Byte range [Start, End) within the original text
+	Text       string // Original text of the segment
+	str        String
+}
+
+// Indicates Thamizh segment
+func (seg TextSegment) IsThamizh() bool { return seg.str.idxs != nil }
+
+// Gets the decoded Thamizh string of the segment; returns false for opaque segment
+func (seg TextSegment) Thamizh() (String, bool) { return seg.str, seg.IsThamizh() }
+
+// Iterator over the ordered segments making up the given (arbitrary UTF-8) text
+//
+// Segments cover the text without gaps, so concatenating the segment texts reproduces the original text.
+// Thamizh code points that cannot be decoded (e.g. stray vowel signs) become part of opaque segments.
+func Segment(text string) iter.Seq[TextSegment] {
+	mapp := func(r unicode.Run) TextSegment {
+		return TextSegment{Start: r.Start, End: r.End, Text: text[r.Start:r.End], str: String{idxs: r.Idxs}}
+	}
+	return mapper(unicode.Runs(text), mapp)
+}
@@ -0,0 +1,47 @@
+package script_test // Black box test
+
+import (
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		text string
+		segs []string // Thamizh segments are prefixed with "+"
+	}{
+		{"தமிழ்", []string{"+தமிழ்"}},
+		{"hello", []string{"hello"}},
+		{"தமிழ் மொழி", []string{"+தமிழ்", " ", "+மொழி"}},
+		{"(Tamil) தமிழ், 2025!", []string{"(Tamil) ", "+தமிழ்", ", 2025!"}},
+		{"ா கா ்", []string{"ா ", "+கா", " ்"}},
+		{"கிுடு", []string{"+கி", "ு", "+டு"}},
+		{"", nil},
+	}
+	for _, tc := range tests {
+		var got []string
+		var sb strings.Builder
+		for seg := range script.Segment(tc.text) {
+			if tc.text[seg.Start:seg.End] != seg.Text {
+				t.Errorf("Segment %q, byte range mismatch for %q", tc.text, seg.Text)
+			}
+			sb.WriteString(seg.Text)
+			if s, ok := seg.Thamizh(); ok {
+				if s.String() != seg.Text {
+					t.Errorf("Segment %q, decoded %s, expected %s", tc.text, s, seg.Text)
+				}
+				got = append(got, "+"+seg.Text)
+			} else {
+				got = append(got, seg.Text)
+			}
+		}
+		if sb.String() != tc.text {
+			t.Errorf("Segment %q, lossless reassembly failed, got %q", tc.text, sb.String())
+		}
+		if strings.Join(got, "|") != strings.Join(tc.segs, "|") {
+			t.Errorf("Segment %q, expected %q, got %q", tc.text, tc.segs, got)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Cross-script transliteration (other Indic scripts), via the letter index
+
+package script
+
+import (
+	"github.com/ThamizhLearner/Thamizh/internal/unicode"
+)
+
+// Indic script
+type Script uint8
+
+// Indic scripts
+const (
+	Thamizh Script = iota
+	Malayalam
+	Devanagari
+	Telugu
+	Kannada
+)
+
+var indicScripts = [...]*unicode.IndicScript{
+	Malayalam:  unicode.Malayalam,
+	Devanagari: unicode.Devanagari,
+	Telugu:     unicode.Telugu,
+	Kannada:    unicode.Kannada,
+}
+
+func (sc Script) indic() *unicode.IndicScript {
+	if sc == Thamizh || int(sc) >= len(indicScripts) {
+		panic("Unexpected script value")
+	}
+	return indicScripts[sc]
+}
+
+// Stringer interface implementation
+func (sc Script) String() string {
+	if sc == Thamizh {
+		return "Thamizh"
+	}
+	return sc.indic().Name()
+}
+
+// Transliterates the string into the given script (letter by letter; consonant row x vowel column)
+//
+// ழ ள ற ன map onto their Dravidian letters (where the script has them), ஃ onto visarga.
+// Note: ந and ன share the base consonant in Malayalam, Telugu and Kannada.
+func Transliterate(s String, target Script) string {
+	if target == Thamizh {
+		return s.String()
+	}
+	return target.indic().Encode(s.idxs)
+}
+
+// Parses the given text of the given script into Thamizh string (inverse of Transliterate)
+//
+// Decomposed vowel signs and Malayalam chillus are accepted. The shared ந/ன base consonant
+// reads as ந word-initially and before த, as ன otherwise.
+// On failure, returns *DecodeError locating the offending code point.
+func ParseScript(text string, source Script) (String, error) {
+	if source == Thamizh {
+		return DecodeErr(text)
+	}
+	idxs, err := source.indic().Decode(text)
+	if err != nil {
+		return String{}, err
+	}
+	return String{idxs: idxs}, nil
+}
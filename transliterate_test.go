@@ -0,0 +1,107 @@
+package script_test // Black box test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	script "github.com/ThamizhLearner/Thamizh"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		ustr   string
+		script script.Script
+		text   string
+	}{
+		{"தமிழ்", script.Malayalam, "തമിഴ്"},
+		{"தமிழ்", script.Devanagari, "तमिऴ्"},
+		{"தமிழ்", script.Telugu, "తమిఴ్"},
+		{"தமிழ்", script.Kannada, "ತಮಿೞ್"},
+		{"நன்றி", script.Malayalam, "നന്റി"},
+		{"நன்றி", script.Devanagari, "नऩ्ऱि"},
+		{"பந்து", script.Telugu, "పన్తు"},
+		{"கொள்கை", script.Kannada, "ಕೊಳ್ಕೈ"},
+		{"எஃகு", script.Devanagari, "ऎःकु"},
+		{"ஸ்ரீ", script.Devanagari, "श्री"},
+		{"க்ஷேமம்", script.Malayalam, "ക്ഷേമമ്"},
+		{"தமிழ்", script.Thamizh, "தமிழ்"},
+	}
+	for _, tc := range tests {
+		s := script.MustDecode(tc.ustr)
+		if got := script.Transliterate(s, tc.script); got != tc.text {
+			t.Errorf("Transliterate %s to %v, expected %s, got %s", tc.ustr, tc.script, tc.text, got)
+		}
+		if got, err := script.ParseScript(tc.text, tc.script); err != nil || got.String() != tc.ustr {
+			t.Errorf("Parse %v %s, expected %s, got %s (%v)", tc.script, tc.text, tc.ustr, got, err)
+		}
+	}
+}
+
+func TestParseScript(t *testing.T) {
+	tests := []struct {
+		text   string
+		script script.Script
+		ustr   string
+	}{
+		{"\u0D15\u0D46\u0D3E\u0D32", script.Malayalam, "கொல"}, // Decomposed ൊ
+		{"അവൻ", script.Malayalam, "அவன்"},                     // Chillu ൻ
+		{"\u0D15\u0D57", script.Malayalam, "கௌ"},              // Modern au sign
+		{"\u0928\u093C\u0940", script.Devanagari, "னீ"},       // न + nukta
+		{"\u0CB9\u0CC6\u0CC2\u0CD5", script.Kannada, "ஹோ"},    // Decomposed ೋ
+		{"అన్నమ్", script.Telugu, "அன்னம்"},                   // Shared ந/ன
+	}
+	for _, tc := range tests {
+		if got, err := script.ParseScript(tc.text, tc.script); err != nil || got.String() != tc.ustr {
+			t.Errorf("Parse %v %q, expected %s, got %s (%v)", tc.script, tc.text, tc.ustr, got, err)
+		}
+	}
+
+	_, err := script.ParseScript("കാ്", script.Malayalam)
+	var decodeErr *script.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Reason != script.ErrDotAfterVowel || decodeErr.RuneOffset != 2 {
+		t.Errorf("Parse Malayalam error, expected pulli after vowel at rune offset 2, got %v", err)
+	}
+	if _, err := script.ParseScript("தமிழ்", script.Telugu); err == nil {
+		t.Errorf("Parse Telugu error expected for Thamizh text")
+	}
+}
+
+func TestTransliterateRoundTrip(t *testing.T) {
+	letters := append(nativeLetterUStrs(), "ஜ", "ஶ்", "ஷா", "ஸி", "ஹௌ", "க்ஷை", "ஸ்ரீ", "ஃ")
+	for _, sc := range []script.Script{script.Malayalam, script.Devanagari, script.Telugu, script.Kannada} {
+		for _, ustr := range letters {
+			want := "அ" + ustr
+			if sc != script.Devanagari {
+				want = strings.ReplaceAll(want, "ந", "ன") // Lossy; one na letter for ந and ன but in Devanagari (ऩ)
+			}
+			s := script.MustDecode("அ" + ustr)
+			text := script.Transliterate(s, sc)
+			got, err := script.ParseScript(text, sc)
+			if err != nil || got.String() != want {
+				t.Errorf("Transliterate round trip %v %s (%s), expected %s, got %s (%v)", sc, s, text, want, got, err)
+			}
+		}
+	}
+
+	// Lossy; ஶ + ் + ரீ reads back as ஸ்ரீ, non-initial ந (but before த) as ன
+	tests := []struct {
+		script    script.Script
+		ustr      string
+		text      string
+		roundTrip string
+	}{
+		{script.Malayalam, "ஶ்ரீ", "ശ്രീ", "ஸ்ரீ"},
+		{script.Devanagari, "ஶ்ரீ", "श्री", "ஸ்ரீ"},
+		{script.Telugu, "ஶ்ரீ", "శ్రీ", "ஸ்ரீ"},
+		{script.Kannada, "ஶ்ரீ", "ಶ್ರೀ", "ஸ்ரீ"},
+		{script.Telugu, "அநேகம்", "అనేకమ్", "அனேகம்"},
+	}
+	for _, tc := range tests {
+		text := script.Transliterate(script.MustDecode(tc.ustr), tc.script)
+		got, err := script.ParseScript(text, tc.script)
+		if text != tc.text || err != nil || got.String() != tc.roundTrip {
+			t.Errorf("Transliterate round trip %v %s, expected %s (%s), got %s (%s, %v)", tc.script, tc.ustr, tc.roundTrip, tc.text, got, text, err)
+		}
+	}
+}